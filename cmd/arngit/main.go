@@ -0,0 +1,59 @@
+// Command arngit is a control layer on top of git: account-aware git
+// operations, GitHub API integration, and automation.
+package main
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/buchorim/arngit/internal/command"
+	"github.com/buchorim/arngit/internal/core"
+	"github.com/buchorim/arngit/internal/ui"
+)
+
+// Version, BuildTime, and GitCommit are set via -ldflags at release build
+// time (see README).
+var (
+	Version   = "dev"
+	BuildTime = "unknown"
+	GitCommit = "unknown"
+)
+
+func main() {
+	engine, err := core.NewEngine()
+	if err != nil {
+		fmt.Fprintln(os.Stderr, "arngit: failed to initialize:", err)
+		os.Exit(1)
+	}
+	engine.Version = Version
+	defer engine.Close()
+
+	router := command.NewRouter(engine, Version)
+
+	if len(os.Args) >= 2 && (os.Args[1] == "--version" || os.Args[1] == "-V") {
+		fmt.Println(Version)
+		return
+	}
+
+	if len(os.Args) < 2 {
+		if !ui.IsTTY(os.Stdin) {
+			fmt.Fprintln(os.Stderr, "arngit: no command given (stdin isn't a terminal, so not starting the interactive shell)")
+			os.Exit(1)
+		}
+		engine.CheckUpdatesBackground()
+		if err := command.RunInteractive(router, engine.CacheDir()); err != nil {
+			fmt.Fprintln(os.Stderr, "arngit:", err)
+			os.Exit(1)
+		}
+		return
+	}
+
+	if err := router.Dispatch(os.Args[1:]); err != nil {
+		engine.Logger.Error(err.Error())
+		fmt.Fprintln(os.Stderr, "arngit:", err)
+		if hint := core.GetErrorHint(err); hint != "" {
+			fmt.Fprintln(os.Stderr, "hint:", hint)
+		}
+		os.Exit(1)
+	}
+}
@@ -0,0 +1,49 @@
+package analytics
+
+import (
+	"time"
+
+	"github.com/buchorim/arngit/internal/git"
+)
+
+// weekdayCount is the number of distinct weekdays time.Weekday enumerates.
+const weekdayCount = 7
+
+// hourCount is the number of hours in a day.
+const hourCount = 24
+
+// CommitActivity buckets commit authorship and timing.
+type CommitActivity struct {
+	ByAuthor  map[string]int
+	ByHour    [hourCount]int
+	ByWeekday [weekdayCount]int
+	Total     int
+}
+
+// GetCommitActivity summarizes up to n commits (0 means no limit),
+// optionally restricted to commits at or after since (the zero time means
+// no restriction).
+func GetCommitActivity(svc *git.Service, n int, since time.Time) (*CommitActivity, error) {
+	records, err := loadCommits(svc, n)
+	if err != nil {
+		return nil, err
+	}
+	return buildCommitActivity(records, since), nil
+}
+
+// buildCommitActivity is the pure aggregation half of GetCommitActivity.
+func buildCommitActivity(records []CommitRecord, since time.Time) *CommitActivity {
+	activity := &CommitActivity{ByAuthor: make(map[string]int)}
+
+	for _, rec := range records {
+		if !since.IsZero() && rec.Time.Before(since) {
+			continue
+		}
+		activity.ByAuthor[rec.AuthorName]++
+		activity.ByHour[rec.Time.Hour()]++
+		activity.ByWeekday[int(rec.Time.Weekday())]++
+		activity.Total++
+	}
+
+	return activity
+}
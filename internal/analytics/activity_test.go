@@ -0,0 +1,48 @@
+package analytics
+
+import (
+	"testing"
+	"time"
+)
+
+func TestBuildCommitActivityBucketsByHourAndWeekday(t *testing.T) {
+	// 2024-01-01 is a Monday.
+	mon10am := time.Date(2024, 1, 1, 10, 0, 0, 0, time.UTC)
+	mon11am := time.Date(2024, 1, 1, 11, 0, 0, 0, time.UTC)
+	tue10am := time.Date(2024, 1, 2, 10, 0, 0, 0, time.UTC)
+
+	records := []CommitRecord{
+		{AuthorName: "Alice", Time: mon10am},
+		{AuthorName: "Alice", Time: mon11am},
+		{AuthorName: "Bob", Time: tue10am},
+	}
+
+	activity := buildCommitActivity(records, time.Time{})
+
+	if activity.Total != 3 {
+		t.Errorf("Total = %d, want 3", activity.Total)
+	}
+	if activity.ByAuthor["Alice"] != 2 || activity.ByAuthor["Bob"] != 1 {
+		t.Errorf("ByAuthor = %v", activity.ByAuthor)
+	}
+	if activity.ByHour[10] != 2 || activity.ByHour[11] != 1 {
+		t.Errorf("ByHour = %v", activity.ByHour)
+	}
+	if activity.ByWeekday[int(time.Monday)] != 2 || activity.ByWeekday[int(time.Tuesday)] != 1 {
+		t.Errorf("ByWeekday = %v", activity.ByWeekday)
+	}
+}
+
+func TestBuildCommitActivitySinceFilter(t *testing.T) {
+	old := time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+	recent := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	records := []CommitRecord{
+		{AuthorName: "Alice", Time: old},
+		{AuthorName: "Alice", Time: recent},
+	}
+
+	activity := buildCommitActivity(records, time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC))
+	if activity.Total != 1 {
+		t.Errorf("Total = %d, want 1 (old commit should be excluded)", activity.Total)
+	}
+}
@@ -0,0 +1,63 @@
+// Package analytics computes repository-level insights (contributor and
+// commit-activity breakdowns) on top of internal/git.
+package analytics
+
+import (
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/buchorim/arngit/internal/git"
+)
+
+// logFormat pulls the author email, author name, and commit unix timestamp
+// for each commit, separated by unit separators so names with spaces or
+// pipes don't break parsing.
+const logFormat = "%ae\x1f%an\x1f%at"
+
+// CommitRecord is one commit's authorship metadata.
+type CommitRecord struct {
+	AuthorEmail string
+	AuthorName  string
+	Time        time.Time
+}
+
+// loadCommits runs `git log` and parses up to n commits (0 means no limit)
+// into CommitRecords, most recent first.
+func loadCommits(svc *git.Service, n int) ([]CommitRecord, error) {
+	raw, err := svc.LogFormat(logFormat, n)
+	if err != nil {
+		return nil, err
+	}
+	return parseCommitRecords(raw)
+}
+
+// parseCommitRecords is the pure parsing half of loadCommits, split out so
+// it can be tested without a real repo.
+func parseCommitRecords(raw string) ([]CommitRecord, error) {
+	if strings.TrimSpace(raw) == "" {
+		return nil, nil
+	}
+
+	lines := strings.Split(raw, "\n")
+	records := make([]CommitRecord, 0, len(lines))
+	for _, line := range lines {
+		if line == "" {
+			continue
+		}
+		parts := strings.SplitN(line, "\x1f", 3)
+		if len(parts) != 3 {
+			continue
+		}
+		ts, err := strconv.ParseInt(parts[2], 10, 64)
+		if err != nil {
+			continue
+		}
+		records = append(records, CommitRecord{
+			AuthorEmail: parts[0],
+			AuthorName:  parts[1],
+			Time:        time.Unix(ts, 0),
+		})
+	}
+	return records, nil
+}
@@ -0,0 +1,43 @@
+package analytics
+
+import "testing"
+
+func TestParseCommitRecords(t *testing.T) {
+	raw := "a@example.com\x1fAlice\x1f1000\n" +
+		"b@example.com\x1fBob\x1f2000\n"
+
+	records, err := parseCommitRecords(raw)
+	if err != nil {
+		t.Fatalf("parseCommitRecords: %v", err)
+	}
+	if len(records) != 2 {
+		t.Fatalf("got %d records, want 2", len(records))
+	}
+	if records[0].AuthorEmail != "a@example.com" || records[0].AuthorName != "Alice" {
+		t.Errorf("unexpected first record: %+v", records[0])
+	}
+	if records[1].Time.Unix() != 2000 {
+		t.Errorf("unexpected second record time: %v", records[1].Time)
+	}
+}
+
+func TestParseCommitRecordsEmpty(t *testing.T) {
+	records, err := parseCommitRecords("")
+	if err != nil {
+		t.Fatalf("parseCommitRecords: %v", err)
+	}
+	if records != nil {
+		t.Errorf("expected nil records for empty input, got %v", records)
+	}
+}
+
+func TestParseCommitRecordsSkipsMalformedLines(t *testing.T) {
+	raw := "a@example.com\x1fAlice\x1fnot-a-timestamp\nb@example.com\x1fBob\x1f2000\n"
+	records, err := parseCommitRecords(raw)
+	if err != nil {
+		t.Fatalf("parseCommitRecords: %v", err)
+	}
+	if len(records) != 1 {
+		t.Fatalf("got %d records, want 1", len(records))
+	}
+}
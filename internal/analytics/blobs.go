@@ -0,0 +1,83 @@
+package analytics
+
+import (
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/buchorim/arngit/internal/git"
+)
+
+// Blob is one object found by LargestBlobs.
+type Blob struct {
+	Hash string
+	Path string
+	Size int64
+}
+
+// LargestBlobs finds the n largest blobs ever committed to svc's history,
+// via `git rev-list --objects --all` (to learn hash-to-path mappings) and
+// `git cat-file --batch-check` (to learn object types and sizes).
+func LargestBlobs(svc *git.Service, n int) ([]Blob, error) {
+	objects, err := svc.RevListObjects()
+	if err != nil {
+		return nil, err
+	}
+
+	hashes, pathByHash := parseRevListObjects(objects)
+	if len(hashes) == 0 {
+		return nil, nil
+	}
+
+	checked, err := svc.CatFileBatchCheck(hashes)
+	if err != nil {
+		return nil, err
+	}
+
+	blobs := parseBatchCheck(checked, pathByHash)
+	sort.Slice(blobs, func(i, j int) bool { return blobs[i].Size > blobs[j].Size })
+
+	if n > 0 && len(blobs) > n {
+		blobs = blobs[:n]
+	}
+	return blobs, nil
+}
+
+// parseRevListObjects splits `rev-list --objects --all` output into the
+// ordered list of hashes and a hash-to-path map for the named ones.
+func parseRevListObjects(raw string) (hashes []string, pathByHash map[string]string) {
+	pathByHash = make(map[string]string)
+	for _, line := range strings.Split(raw, "\n") {
+		if line == "" {
+			continue
+		}
+		parts := strings.SplitN(line, " ", 2)
+		hashes = append(hashes, parts[0])
+		if len(parts) == 2 && parts[1] != "" {
+			pathByHash[parts[0]] = parts[1]
+		}
+	}
+	return hashes, pathByHash
+}
+
+// parseBatchCheck turns `cat-file --batch-check` output into Blobs, keeping
+// only "blob" typed objects.
+func parseBatchCheck(raw string, pathByHash map[string]string) []Blob {
+	var blobs []Blob
+	for _, line := range strings.Split(raw, "\n") {
+		fields := strings.Fields(line)
+		if len(fields) != 3 || fields[1] != "blob" {
+			continue
+		}
+		size, err := strconv.ParseInt(fields[2], 10, 64)
+		if err != nil {
+			continue
+		}
+		path := pathByHash[fields[0]]
+		if path == "" {
+			path = "(unknown path)"
+		}
+		blobs = append(blobs, Blob{Hash: fields[0], Path: path, Size: size})
+	}
+	return blobs
+}
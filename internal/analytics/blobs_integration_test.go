@@ -0,0 +1,49 @@
+package analytics
+
+import (
+	"bytes"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"testing"
+
+	"github.com/buchorim/arngit/internal/git"
+)
+
+func TestLargestBlobsFindsKnownLargeFile(t *testing.T) {
+	if _, err := exec.LookPath("git"); err != nil {
+		t.Skip("git not installed")
+	}
+
+	dir := t.TempDir()
+	run := func(args ...string) {
+		cmd := exec.Command("git", args...)
+		cmd.Dir = dir
+		if out, err := cmd.CombinedOutput(); err != nil {
+			t.Fatalf("git %v: %v: %s", args, err, out)
+		}
+	}
+	run("init", "-q")
+	run("config", "user.email", "test@example.com")
+	run("config", "user.name", "test")
+
+	small := []byte("hello\n")
+	large := bytes.Repeat([]byte("x"), 50_000)
+
+	os.WriteFile(filepath.Join(dir, "small.txt"), small, 0o644)
+	os.WriteFile(filepath.Join(dir, "big.bin"), large, 0o644)
+	run("add", ".")
+	run("commit", "-q", "-m", "add files")
+
+	svc := git.NewService(dir)
+	blobs, err := LargestBlobs(svc, 5)
+	if err != nil {
+		t.Fatalf("LargestBlobs: %v", err)
+	}
+	if len(blobs) == 0 {
+		t.Fatal("expected at least one blob")
+	}
+	if blobs[0].Path != "big.bin" || blobs[0].Size != int64(len(large)) {
+		t.Errorf("largest blob = %+v, want big.bin with size %d", blobs[0], len(large))
+	}
+}
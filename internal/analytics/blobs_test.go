@@ -0,0 +1,48 @@
+package analytics
+
+import "testing"
+
+func TestParseRevListObjects(t *testing.T) {
+	raw := "abc123\n" +
+		"def456 README.md\n" +
+		"ghi789 src/main.go\n"
+
+	hashes, pathByHash := parseRevListObjects(raw)
+	if len(hashes) != 3 {
+		t.Fatalf("got %d hashes, want 3", len(hashes))
+	}
+	if pathByHash["def456"] != "README.md" {
+		t.Errorf("pathByHash[def456] = %q", pathByHash["def456"])
+	}
+	if _, ok := pathByHash["abc123"]; ok {
+		t.Error("expected unnamed object to have no path entry")
+	}
+}
+
+func TestParseBatchCheck(t *testing.T) {
+	raw := "abc123 commit 200\n" +
+		"def456 blob 1024\n" +
+		"ghi789 blob 4096\n"
+	paths := map[string]string{"def456": "README.md", "ghi789": "big.bin"}
+
+	blobs := parseBatchCheck(raw, paths)
+	if len(blobs) != 2 {
+		t.Fatalf("got %d blobs, want 2 (commits should be excluded)", len(blobs))
+	}
+
+	byHash := map[string]Blob{}
+	for _, b := range blobs {
+		byHash[b.Hash] = b
+	}
+	if byHash["ghi789"].Size != 4096 || byHash["ghi789"].Path != "big.bin" {
+		t.Errorf("unexpected blob: %+v", byHash["ghi789"])
+	}
+}
+
+func TestParseBatchCheckUnknownPath(t *testing.T) {
+	raw := "def456 blob 10\n"
+	blobs := parseBatchCheck(raw, map[string]string{})
+	if len(blobs) != 1 || blobs[0].Path != "(unknown path)" {
+		t.Errorf("unexpected blobs: %+v", blobs)
+	}
+}
@@ -0,0 +1,63 @@
+package analytics
+
+import (
+	"strconv"
+	"strings"
+
+	"github.com/buchorim/arngit/internal/git"
+)
+
+// FileChurn totals one file's line changes across the scanned commits.
+type FileChurn struct {
+	Insertions int
+	Deletions  int
+}
+
+// ChurnStats totals insertions/deletions across a commit range.
+type ChurnStats struct {
+	Insertions int
+	Deletions  int
+	ByFile     map[string]FileChurn
+}
+
+// GetChurn totals insertions and deletions per file since the given
+// date/revision expression (empty means the whole history), via `git log
+// --numstat`.
+func GetChurn(svc *git.Service, since string) (*ChurnStats, error) {
+	raw, err := svc.NumstatLog(since)
+	if err != nil {
+		return nil, err
+	}
+	return parseNumstat(raw), nil
+}
+
+// parseNumstat is the pure parsing half of GetChurn. Each `--numstat` line
+// is "insertions\tdeletions\tpath"; binary files report "-" for both counts
+// and are skipped since they have no meaningful line churn.
+func parseNumstat(raw string) *ChurnStats {
+	stats := &ChurnStats{ByFile: make(map[string]FileChurn)}
+
+	for _, line := range strings.Split(raw, "\n") {
+		parts := strings.Split(line, "\t")
+		if len(parts) != 3 {
+			continue
+		}
+
+		insertions, err1 := strconv.Atoi(parts[0])
+		deletions, err2 := strconv.Atoi(parts[1])
+		if err1 != nil || err2 != nil {
+			continue // binary file ("-\t-\tpath") or malformed
+		}
+
+		path := parts[2]
+		fc := stats.ByFile[path]
+		fc.Insertions += insertions
+		fc.Deletions += deletions
+		stats.ByFile[path] = fc
+
+		stats.Insertions += insertions
+		stats.Deletions += deletions
+	}
+
+	return stats
+}
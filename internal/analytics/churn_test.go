@@ -0,0 +1,33 @@
+package analytics
+
+import "testing"
+
+func TestParseNumstat(t *testing.T) {
+	raw := "10\t2\tmain.go\n" +
+		"0\t5\tREADME.md\n" +
+		"\n" +
+		"3\t0\tmain.go\n" +
+		"-\t-\tlogo.png\n"
+
+	stats := parseNumstat(raw)
+
+	if stats.Insertions != 13 {
+		t.Errorf("Insertions = %d, want 13", stats.Insertions)
+	}
+	if stats.Deletions != 7 {
+		t.Errorf("Deletions = %d, want 7", stats.Deletions)
+	}
+	if got := stats.ByFile["main.go"]; got.Insertions != 13 || got.Deletions != 2 {
+		t.Errorf("ByFile[main.go] = %+v", got)
+	}
+	if _, ok := stats.ByFile["logo.png"]; ok {
+		t.Error("expected binary file entry to be skipped")
+	}
+}
+
+func TestParseNumstatEmpty(t *testing.T) {
+	stats := parseNumstat("")
+	if stats.Insertions != 0 || stats.Deletions != 0 || len(stats.ByFile) != 0 {
+		t.Errorf("expected empty stats, got %+v", stats)
+	}
+}
@@ -0,0 +1,104 @@
+package analytics
+
+import (
+	"regexp"
+	"sort"
+	"time"
+
+	"github.com/buchorim/arngit/internal/git"
+)
+
+// Contributor summarizes one deduplicated author's activity.
+type Contributor struct {
+	Name    string
+	Email   string
+	Count   int
+	First   time.Time
+	Last    time.Time
+	Percent float64
+}
+
+// mailmapEmailPattern extracts the email from `git check-mailmap`'s
+// "Name <email>" output.
+var mailmapEmailPattern = regexp.MustCompile(`<([^>]+)>`)
+
+// GetContributors aggregates commit authorship into one Contributor per
+// person. With useMailmap, authors are merged by their .mailmap canonical
+// email so the same person under multiple aliases is counted once.
+func GetContributors(svc *git.Service, useMailmap bool) ([]Contributor, error) {
+	records, err := loadCommits(svc, 0)
+	if err != nil {
+		return nil, err
+	}
+
+	resolve := func(email string) string { return email }
+	if useMailmap {
+		cache := make(map[string]string)
+		resolve = func(email string) string {
+			if canonical, ok := cache[email]; ok {
+				return canonical
+			}
+			canonical := resolveMailmap(svc, email)
+			cache[email] = canonical
+			return canonical
+		}
+	}
+
+	return aggregateContributors(records, resolve), nil
+}
+
+// aggregateContributors is the pure aggregation half of GetContributors,
+// split out so alias-merging can be tested without a real repo.
+func aggregateContributors(records []CommitRecord, resolve func(email string) string) []Contributor {
+	byEmail := make(map[string]*Contributor)
+	var order []string
+
+	for _, rec := range records {
+		key := resolve(rec.AuthorEmail)
+
+		c, ok := byEmail[key]
+		if !ok {
+			c = &Contributor{Name: rec.AuthorName, Email: key, First: rec.Time, Last: rec.Time}
+			byEmail[key] = c
+			order = append(order, key)
+		}
+		c.Count++
+		if rec.Time.Before(c.First) {
+			c.First = rec.Time
+		}
+		if rec.Time.After(c.Last) {
+			c.Last = rec.Time
+		}
+	}
+
+	contributors := make([]Contributor, 0, len(order))
+	for _, key := range order {
+		contributors = append(contributors, *byEmail[key])
+	}
+
+	total := len(records)
+	for i := range contributors {
+		if total > 0 {
+			contributors[i].Percent = float64(contributors[i].Count) / float64(total) * 100
+		}
+	}
+
+	sort.Slice(contributors, func(i, j int) bool {
+		return contributors[i].Count > contributors[j].Count
+	})
+
+	return contributors
+}
+
+// resolveMailmap looks up email's canonical form via .mailmap, falling back
+// to the raw email if git can't resolve it (e.g. no .mailmap present).
+func resolveMailmap(svc *git.Service, email string) string {
+	out, err := svc.CheckMailmap(email)
+	if err != nil {
+		return email
+	}
+	if m := mailmapEmailPattern.FindStringSubmatch(out); m != nil {
+		return m[1]
+	}
+	return email
+}
@@ -0,0 +1,51 @@
+package analytics
+
+import (
+	"testing"
+	"time"
+)
+
+func TestAggregateContributorsMergesAliases(t *testing.T) {
+	records := []CommitRecord{
+		{AuthorEmail: "alice@work.com", AuthorName: "Alice", Time: time.Unix(100, 0)},
+		{AuthorEmail: "alice@personal.com", AuthorName: "Alice", Time: time.Unix(300, 0)},
+		{AuthorEmail: "bob@example.com", AuthorName: "Bob", Time: time.Unix(200, 0)},
+	}
+
+	// Simulate .mailmap resolving both alice addresses to one canonical email.
+	resolve := func(email string) string {
+		if email == "alice@personal.com" {
+			return "alice@work.com"
+		}
+		return email
+	}
+
+	contributors := aggregateContributors(records, resolve)
+	if len(contributors) != 2 {
+		t.Fatalf("got %d contributors, want 2 (aliases should merge)", len(contributors))
+	}
+
+	alice := contributors[0]
+	if alice.Email != "alice@work.com" || alice.Count != 2 {
+		t.Errorf("unexpected merged contributor: %+v", alice)
+	}
+	if !alice.First.Equal(time.Unix(100, 0)) || !alice.Last.Equal(time.Unix(300, 0)) {
+		t.Errorf("expected first/last spanning both commits, got %+v", alice)
+	}
+	if alice.Percent < 66 || alice.Percent > 67 {
+		t.Errorf("expected ~66%% share, got %v", alice.Percent)
+	}
+}
+
+func TestAggregateContributorsWithoutMailmap(t *testing.T) {
+	records := []CommitRecord{
+		{AuthorEmail: "alice@work.com", AuthorName: "Alice", Time: time.Unix(100, 0)},
+		{AuthorEmail: "alice@personal.com", AuthorName: "Alice", Time: time.Unix(300, 0)},
+	}
+
+	identity := func(email string) string { return email }
+	contributors := aggregateContributors(records, identity)
+	if len(contributors) != 2 {
+		t.Fatalf("got %d contributors, want 2 (no dedup without mailmap)", len(contributors))
+	}
+}
@@ -0,0 +1,59 @@
+package analytics
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/buchorim/arngit/internal/git"
+)
+
+// LanguageStat totals one file-extension's footprint in the tree.
+type LanguageStat struct {
+	Files int
+	Lines int
+	Bytes int64
+}
+
+// GetLanguageBreakdown counts lines and bytes per file extension across
+// every tracked file in svc's working tree.
+func GetLanguageBreakdown(svc *git.Service) (map[string]LanguageStat, error) {
+	raw, err := svc.LsFiles()
+	if err != nil {
+		return nil, err
+	}
+
+	breakdown := make(map[string]LanguageStat)
+	for _, path := range strings.Split(raw, "\n") {
+		if path == "" {
+			continue
+		}
+		ext := extensionOf(path)
+
+		data, err := os.ReadFile(filepath.Join(svc.Dir, path))
+		if err != nil {
+			// Deleted-but-still-indexed or a submodule gitlink; skip it.
+			continue
+		}
+
+		stat := breakdown[ext]
+		stat.Files++
+		stat.Bytes += int64(len(data))
+		if len(data) > 0 {
+			stat.Lines += strings.Count(string(data), "\n") + 1
+		}
+		breakdown[ext] = stat
+	}
+
+	return breakdown, nil
+}
+
+// extensionOf returns a file's extension (without the dot), or "(none)" for
+// extensionless files like "Makefile".
+func extensionOf(path string) string {
+	ext := filepath.Ext(path)
+	if ext == "" {
+		return "(none)"
+	}
+	return strings.TrimPrefix(ext, ".")
+}
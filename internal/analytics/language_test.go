@@ -0,0 +1,17 @@
+package analytics
+
+import "testing"
+
+func TestExtensionOf(t *testing.T) {
+	tests := map[string]string{
+		"main.go":       "go",
+		"README.md":     "md",
+		"Makefile":      "(none)",
+		"internal/a.go": "go",
+	}
+	for path, want := range tests {
+		if got := extensionOf(path); got != want {
+			t.Errorf("extensionOf(%q) = %q, want %q", path, got, want)
+		}
+	}
+}
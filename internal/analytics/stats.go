@@ -0,0 +1,22 @@
+package analytics
+
+import (
+	"time"
+
+	"github.com/buchorim/arngit/internal/git"
+)
+
+// RepoStats bundles the per-repo insights `arngit stats` reports.
+type RepoStats struct {
+	Activity *CommitActivity
+}
+
+// GetRepoStats gathers repo stats from up to limit commits (0 means no
+// limit), optionally restricted to the last `since` window.
+func GetRepoStats(svc *git.Service, limit int, since time.Time) (*RepoStats, error) {
+	activity, err := GetCommitActivity(svc, limit, since)
+	if err != nil {
+		return nil, err
+	}
+	return &RepoStats{Activity: activity}, nil
+}
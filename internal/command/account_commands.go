@@ -0,0 +1,85 @@
+package command
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/buchorim/arngit/internal/ui"
+)
+
+// registerAccountCommands wires up `arngit account`.
+func (r *Router) registerAccountCommands() {
+	r.registerCommand(Command{
+		Name:        "account",
+		Handler:     handleAccount,
+		Subcommands: []string{"add", "list", "use"},
+	})
+}
+
+// handleAccount dispatches `arngit account [add|list|use]`, defaulting to
+// list with no subcommand.
+func handleAccount(ctx *Context) error {
+	if len(ctx.Args) == 0 {
+		return accountList(ctx)
+	}
+
+	switch ctx.Args[0] {
+	case "add":
+		return accountAdd(ctx)
+	case "list":
+		return accountList(ctx)
+	case "use":
+		return accountUse(ctx, ctx.Args[1:])
+	default:
+		return fmt.Errorf("unknown account subcommand: %s", ctx.Args[0])
+	}
+}
+
+// accountAdd prompts for a name, GitHub username, and personal access
+// token, and saves them as a new account. The first account added becomes
+// the active one.
+func accountAdd(ctx *Context) error {
+	name := ui.Prompt(os.Stdin, os.Stdout, "Account name")
+	username := ui.Prompt(os.Stdin, os.Stdout, "GitHub username")
+	token := ui.Prompt(os.Stdin, os.Stdout, "Personal access token")
+
+	if name == "" || username == "" || token == "" {
+		return fmt.Errorf("name, username, and token are all required")
+	}
+
+	if err := ctx.Engine.Accounts.Add(name, username, token); err != nil {
+		return err
+	}
+	if ctx.Engine.Accounts.Active == "" {
+		ctx.Engine.Accounts.Active = name
+	}
+	if err := ctx.Engine.Accounts.Save(); err != nil {
+		return err
+	}
+
+	ctx.Renderer.Success(fmt.Sprintf("added account %q", name))
+	return nil
+}
+
+func accountList(ctx *Context) error {
+	for _, a := range ctx.Engine.Accounts.Accounts {
+		marker := "  "
+		if a.Name == ctx.Engine.Accounts.Active {
+			marker = "* "
+		}
+		ctx.Renderer.Plain(fmt.Sprintf("%s%s (%s)", marker, a.Name, a.Username))
+	}
+	return nil
+}
+
+func accountUse(ctx *Context, args []string) error {
+	if len(args) != 1 {
+		return fmt.Errorf("usage: arngit account use <name>")
+	}
+	ctx.Engine.Accounts.Active = args[0]
+	if err := ctx.Engine.Accounts.Save(); err != nil {
+		return err
+	}
+	ctx.Renderer.Success(fmt.Sprintf("switched to account %q", args[0]))
+	return nil
+}
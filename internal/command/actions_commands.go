@@ -0,0 +1,129 @@
+package command
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/buchorim/arngit/internal/github"
+)
+
+// actionsFlags declares the flags `arngit actions` and its subcommands
+// accept.
+var actionsFlags = []FlagSpec{
+	{Name: "branch", Description: "Only show runs for this branch", TakesValue: true},
+	{Name: "status", Description: "Only show runs with this status or conclusion (e.g. failure)", TakesValue: true},
+	{Name: "ref", Description: "Branch or tag to dispatch the workflow on", TakesValue: true},
+}
+
+// registerActionsCommands wires up `arngit actions`.
+func (r *Router) registerActionsCommands() {
+	r.registerCommand(Command{
+		Name:            "actions",
+		Handler:         handleActions,
+		RequiresRepo:    true,
+		RequiresAccount: true,
+		Subcommands:     []string{"rerun", "dispatch"},
+		Flags:           actionsFlags,
+	})
+}
+
+// handleActions shows recent GitHub Actions workflow run status for the
+// current repo by default, or dispatches to `rerun`/`dispatch` when given
+// one of those subcommands.
+func handleActions(ctx *Context) error {
+	owner, repo, err := currentOwnerRepo(ctx)
+	if err != nil {
+		return err
+	}
+	client, err := ctx.Engine.GitHubClient()
+	if err != nil {
+		return err
+	}
+
+	if len(ctx.Args) > 0 {
+		switch ctx.Args[0] {
+		case "rerun":
+			return actionsRerun(ctx, client, owner, repo, ctx.Args[1:])
+		case "dispatch":
+			return actionsDispatch(ctx, client, owner, repo, ctx.Args[1:])
+		}
+	}
+
+	flags := parseFlags(actionsFlags, ctx.Args)
+	runs, err := client.ListWorkflowRuns(owner, repo, github.WorkflowRunOptions{
+		Branch: flags.Value["branch"],
+		Status: flags.Value["status"],
+	})
+	if err != nil {
+		return wrapGitHubError(err)
+	}
+	renderWorkflowRuns(ctx, runs)
+	return nil
+}
+
+// actionsRerun re-runs the workflow run identified by args[0], after
+// confirming since it kicks off billable CI minutes.
+func actionsRerun(ctx *Context, client *github.Client, owner, repo string, args []string) error {
+	if len(args) != 1 {
+		return fmt.Errorf("usage: arngit actions rerun <run-id>")
+	}
+	runID, err := strconv.ParseInt(args[0], 10, 64)
+	if err != nil {
+		return fmt.Errorf("invalid run id %q: %w", args[0], err)
+	}
+
+	if !ctx.Confirm(fmt.Sprintf("re-run workflow run %d?", runID)) {
+		return fmt.Errorf("aborted")
+	}
+	if ctx.DryRun {
+		ctx.Renderer.Info(fmt.Sprintf("dry run: would re-run workflow run %d", runID))
+		return nil
+	}
+
+	if err := client.RerunWorkflowRun(owner, repo, runID); err != nil {
+		return wrapGitHubError(err)
+	}
+	ctx.Renderer.Success(fmt.Sprintf("re-ran workflow run %d", runID))
+	return nil
+}
+
+// actionsDispatch triggers a workflow_dispatch event for args[0] (a
+// workflow file name or ID) on the branch/tag given by --ref.
+func actionsDispatch(ctx *Context, client *github.Client, owner, repo string, args []string) error {
+	flags := parseFlags(actionsFlags, args)
+	if len(flags.Positional) != 1 {
+		return fmt.Errorf("usage: arngit actions dispatch <workflow> --ref <branch>")
+	}
+	workflow := flags.Positional[0]
+	ref := flags.Value["ref"]
+	if ref == "" {
+		return fmt.Errorf("--ref is required")
+	}
+
+	if ctx.DryRun {
+		ctx.Renderer.Info(fmt.Sprintf("dry run: would dispatch %s on %s", workflow, ref))
+		return nil
+	}
+
+	if err := client.DispatchWorkflow(owner, repo, workflow, ref); err != nil {
+		return wrapGitHubError(err)
+	}
+	ctx.Renderer.Success(fmt.Sprintf("dispatched %s on %s", workflow, ref))
+	return nil
+}
+
+// renderWorkflowRuns prints one line per run: conclusion, branch, actor,
+// and URL, most recent first.
+func renderWorkflowRuns(ctx *Context, runs []github.WorkflowRun) {
+	if len(runs) == 0 {
+		ctx.Renderer.Plain("no workflow runs found")
+		return
+	}
+	for _, run := range runs {
+		state := run.Conclusion
+		if state == "" {
+			state = run.Status
+		}
+		ctx.Renderer.Plain(fmt.Sprintf("%-10s %-20s %-15s %s  %s", state, run.HeadBranch, run.Actor.Login, run.Name, run.HTMLURL))
+	}
+}
@@ -0,0 +1,115 @@
+package command
+
+import (
+	"errors"
+	"os"
+	"os/exec"
+	"strings"
+	"testing"
+
+	"github.com/buchorim/arngit/internal/core"
+	"github.com/buchorim/arngit/internal/github"
+	"github.com/buchorim/arngit/internal/ui"
+)
+
+func TestRenderWorkflowRunsPrintsTable(t *testing.T) {
+	ctx := &Context{Renderer: ui.NewRenderer(false, "")}
+	runs := []github.WorkflowRun{
+		{ID: 1, Name: "CI", HeadBranch: "main", Status: "completed", Conclusion: "failure", HTMLURL: "https://github.com/acme/widgets/actions/runs/1", Actor: struct {
+			Login string `json:"login"`
+		}{Login: "octocat"}},
+	}
+
+	out := captureStdout(t, func() {
+		renderWorkflowRuns(ctx, runs)
+	})
+
+	if !strings.Contains(out, "failure") || !strings.Contains(out, "main") || !strings.Contains(out, "octocat") || !strings.Contains(out, "runs/1") {
+		t.Errorf("output missing expected fields: %q", out)
+	}
+}
+
+func TestRenderWorkflowRunsReportsEmpty(t *testing.T) {
+	ctx := &Context{Renderer: ui.NewRenderer(false, "")}
+	out := captureStdout(t, func() {
+		renderWorkflowRuns(ctx, nil)
+	})
+	if !strings.Contains(out, "no workflow runs found") {
+		t.Errorf("output = %q", out)
+	}
+}
+
+func TestActionsRerunAbortsWithoutConfirmation(t *testing.T) {
+	if _, err := exec.LookPath("git"); err != nil {
+		t.Skip("git not installed")
+	}
+	dir := t.TempDir()
+	if out, err := exec.Command("git", "init", "-q", dir).CombinedOutput(); err != nil {
+		t.Fatalf("git init: %v: %s", err, out)
+	}
+	if out, err := exec.Command("git", "-C", dir, "remote", "add", "origin", "git@github.com:acme/widgets.git").CombinedOutput(); err != nil {
+		t.Fatalf("git remote add: %v: %s", err, out)
+	}
+	chdir(t, dir)
+
+	readEnd, writeEnd, err := os.Pipe()
+	if err != nil {
+		t.Fatalf("Pipe: %v", err)
+	}
+	writeEnd.Close()
+	originalStdin := os.Stdin
+	os.Stdin = readEnd
+	defer func() { os.Stdin = originalStdin }()
+
+	router := NewRouter(&core.Engine{Config: core.DefaultConfig(), Accounts: &core.AccountStore{Active: "work"}}, "test")
+	err = router.Dispatch([]string{"actions", "rerun", "42"})
+	if err == nil {
+		t.Fatal("expected an error when confirmation is declined")
+	}
+}
+
+func TestActionsDispatchRequiresRef(t *testing.T) {
+	if _, err := exec.LookPath("git"); err != nil {
+		t.Skip("git not installed")
+	}
+	dir := t.TempDir()
+	if out, err := exec.Command("git", "init", "-q", dir).CombinedOutput(); err != nil {
+		t.Fatalf("git init: %v: %s", err, out)
+	}
+	if out, err := exec.Command("git", "-C", dir, "remote", "add", "origin", "git@github.com:acme/widgets.git").CombinedOutput(); err != nil {
+		t.Fatalf("git remote add: %v: %s", err, out)
+	}
+	chdir(t, dir)
+
+	router := NewRouter(&core.Engine{Config: core.DefaultConfig(), Accounts: &core.AccountStore{Active: "work"}}, "test")
+	err := router.Dispatch([]string{"actions", "dispatch", "ci.yml"})
+	if err == nil {
+		t.Fatal("expected an error when --ref is missing")
+	}
+}
+
+func TestActionsRequiresAccountDeclinesWithoutAccount(t *testing.T) {
+	if _, err := exec.LookPath("git"); err != nil {
+		t.Skip("git not installed")
+	}
+	dir := t.TempDir()
+	if out, err := exec.Command("git", "init", "-q", dir).CombinedOutput(); err != nil {
+		t.Fatalf("git init: %v: %s", err, out)
+	}
+	chdir(t, dir)
+
+	readEnd, writeEnd, err := os.Pipe()
+	if err != nil {
+		t.Fatalf("Pipe: %v", err)
+	}
+	writeEnd.Close()
+	originalStdin := os.Stdin
+	os.Stdin = readEnd
+	defer func() { os.Stdin = originalStdin }()
+
+	router := NewRouter(&core.Engine{Config: core.DefaultConfig(), Accounts: &core.AccountStore{}}, "test")
+	err = router.Dispatch([]string{"actions"})
+	if !errors.Is(err, core.ErrNoAccount) {
+		t.Errorf("err = %v, want ErrNoAccount", err)
+	}
+}
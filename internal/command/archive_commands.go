@@ -0,0 +1,53 @@
+package command
+
+import (
+	"fmt"
+)
+
+var archiveFlags = []FlagSpec{
+	{Name: "format", Description: "Archive format: zip, tar, or tar.gz (default zip)", TakesValue: true},
+	{Name: "output", Shorthand: "o", Description: "File to write the archive to", TakesValue: true},
+}
+
+// registerArchiveCommands wires up `arngit archive`.
+func (r *Router) registerArchiveCommands() {
+	r.registerCommand(Command{
+		Name:         "archive",
+		Handler:      handleArchive,
+		RequiresRepo: true,
+		Flags:        archiveFlags,
+	})
+}
+
+// handleArchive exports a snapshot of a ref via `git archive`, defaulting to
+// HEAD in zip format.
+func handleArchive(ctx *Context) error {
+	flags := parseFlags(archiveFlags, ctx.Args)
+
+	ref := "HEAD"
+	if len(flags.Positional) > 0 {
+		ref = flags.Positional[0]
+	}
+
+	format := flags.Value["format"]
+	if format == "" {
+		format = "zip"
+	}
+
+	output := flags.Value["output"]
+	if output == "" {
+		output = "archive." + format
+	}
+
+	if ctx.DryRun {
+		ctx.Renderer.Info(fmt.Sprintf("dry run: would write %s (format %s, ref %s)", output, format, ref))
+		return nil
+	}
+
+	if err := ctx.Git.Archive(ref, format, output); err != nil {
+		return err
+	}
+
+	ctx.Renderer.Success(fmt.Sprintf("wrote %s", output))
+	return nil
+}
@@ -0,0 +1,74 @@
+package command
+
+import (
+	"os"
+	"os/exec"
+	"path/filepath"
+	"testing"
+
+	"github.com/buchorim/arngit/internal/core"
+)
+
+func TestArchiveWritesFileForHEAD(t *testing.T) {
+	if _, err := exec.LookPath("git"); err != nil {
+		t.Skip("git not installed")
+	}
+
+	dir := t.TempDir()
+	run := func(args ...string) {
+		cmd := exec.Command("git", args...)
+		cmd.Dir = dir
+		if out, err := cmd.CombinedOutput(); err != nil {
+			t.Fatalf("git %v: %v: %s", args, err, out)
+		}
+	}
+	run("init", "-q")
+	run("config", "user.email", "test@example.com")
+	run("config", "user.name", "test")
+	if err := os.WriteFile(filepath.Join(dir, "f.txt"), []byte("hi"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	run("add", ".")
+	run("commit", "-q", "-m", "commit")
+
+	chdir(t, dir)
+	router := NewRouter(&core.Engine{Config: core.DefaultConfig()}, "test")
+
+	if err := router.Dispatch([]string{"archive", "--output=snapshot.zip"}); err != nil {
+		t.Fatalf("archive: %v", err)
+	}
+
+	if _, err := os.Stat(filepath.Join(dir, "snapshot.zip")); err != nil {
+		t.Fatalf("expected archive file: %v", err)
+	}
+}
+
+func TestArchiveRejectsUnknownFormat(t *testing.T) {
+	if _, err := exec.LookPath("git"); err != nil {
+		t.Skip("git not installed")
+	}
+
+	dir := t.TempDir()
+	run := func(args ...string) {
+		cmd := exec.Command("git", args...)
+		cmd.Dir = dir
+		if out, err := cmd.CombinedOutput(); err != nil {
+			t.Fatalf("git %v: %v: %s", args, err, out)
+		}
+	}
+	run("init", "-q")
+	run("config", "user.email", "test@example.com")
+	run("config", "user.name", "test")
+	if err := os.WriteFile(filepath.Join(dir, "f.txt"), []byte("hi"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	run("add", ".")
+	run("commit", "-q", "-m", "commit")
+
+	chdir(t, dir)
+	router := NewRouter(&core.Engine{Config: core.DefaultConfig()}, "test")
+
+	if err := router.Dispatch([]string{"archive", "--format=rar"}); err == nil {
+		t.Fatal("expected an error for an unsupported format")
+	}
+}
@@ -0,0 +1,95 @@
+package command
+
+import (
+	"fmt"
+
+	"github.com/buchorim/arngit/internal/git"
+)
+
+// registerBisectCommands wires up `arngit bisect`.
+func (r *Router) registerBisectCommands() {
+	r.registerCommand(Command{
+		Name:         "bisect",
+		Handler:      handleBisect,
+		RequiresRepo: true,
+		Subcommands:  []string{"start", "good", "bad", "reset", "run"},
+	})
+}
+
+// handleBisect dispatches `arngit bisect <start|good|bad|reset|run> ...`.
+func handleBisect(ctx *Context) error {
+	if len(ctx.Args) == 0 {
+		return fmt.Errorf("usage: arngit bisect <start|good|bad|reset|run> ...")
+	}
+
+	args := ctx.Args[1:]
+	switch ctx.Args[0] {
+	case "start":
+		bad, good := "", ""
+		if len(args) > 0 {
+			bad = args[0]
+		}
+		if len(args) > 1 {
+			good = args[1]
+		}
+		out, err := ctx.Git.BisectStart(bad, good)
+		if err != nil {
+			return err
+		}
+		ctx.Renderer.Success("bisect started")
+		ctx.Renderer.Plain(out)
+		return nil
+
+	case "good":
+		rev := ""
+		if len(args) > 0 {
+			rev = args[0]
+		}
+		out, err := ctx.Git.BisectGood(rev)
+		if err != nil {
+			return err
+		}
+		ctx.Renderer.Plain(out)
+		return nil
+
+	case "bad":
+		rev := ""
+		if len(args) > 0 {
+			rev = args[0]
+		}
+		out, err := ctx.Git.BisectBad(rev)
+		if err != nil {
+			return err
+		}
+		ctx.Renderer.Plain(out)
+		return nil
+
+	case "reset":
+		out, err := ctx.Git.BisectReset()
+		if err != nil {
+			return err
+		}
+		ctx.Renderer.Success("bisect reset")
+		if out != "" {
+			ctx.Renderer.Plain(out)
+		}
+		return nil
+
+	case "run":
+		if len(args) == 0 {
+			return fmt.Errorf("usage: arngit bisect run <cmd> [args...]")
+		}
+		out, err := ctx.Git.BisectRun(args[0], args[1:]...)
+		if err != nil {
+			return err
+		}
+		ctx.Renderer.Plain(out)
+		if hash, ok := git.ParseBisectResult(out); ok {
+			ctx.Renderer.Success(fmt.Sprintf("first bad commit: %s", hash))
+		}
+		return nil
+
+	default:
+		return fmt.Errorf("unknown bisect subcommand: %s", ctx.Args[0])
+	}
+}
@@ -0,0 +1,51 @@
+package command
+
+import (
+	"os"
+	"os/exec"
+	"path/filepath"
+	"testing"
+
+	"github.com/buchorim/arngit/internal/core"
+)
+
+func TestBisectStartGoodBadReset(t *testing.T) {
+	if _, err := exec.LookPath("git"); err != nil {
+		t.Skip("git not installed")
+	}
+
+	dir := t.TempDir()
+	run := func(args ...string) {
+		cmd := exec.Command("git", args...)
+		cmd.Dir = dir
+		if out, err := cmd.CombinedOutput(); err != nil {
+			t.Fatalf("git %v: %v: %s", args, err, out)
+		}
+	}
+	run("init", "-q")
+	run("config", "user.email", "test@example.com")
+	run("config", "user.name", "test")
+	for i := 0; i < 3; i++ {
+		if err := os.WriteFile(filepath.Join(dir, "f.txt"), []byte{byte('a' + i)}, 0o644); err != nil {
+			t.Fatal(err)
+		}
+		run("add", ".")
+		run("commit", "-q", "-m", "commit")
+	}
+
+	chdir(t, dir)
+	router := NewRouter(&core.Engine{Config: core.DefaultConfig()}, "test")
+
+	if err := router.Dispatch([]string{"bisect", "start"}); err != nil {
+		t.Fatalf("bisect start: %v", err)
+	}
+	if err := router.Dispatch([]string{"bisect", "bad", "HEAD"}); err != nil {
+		t.Fatalf("bisect bad: %v", err)
+	}
+	if err := router.Dispatch([]string{"bisect", "good", "HEAD~2"}); err != nil {
+		t.Fatalf("bisect good: %v", err)
+	}
+	if err := router.Dispatch([]string{"bisect", "reset"}); err != nil {
+		t.Fatalf("bisect reset: %v", err)
+	}
+}
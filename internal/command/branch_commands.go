@@ -0,0 +1,158 @@
+package command
+
+import "fmt"
+
+// branchListFlags declares the flags `branch list` accepts.
+var branchListFlags = []FlagSpec{
+	{Name: "merged", Description: "List only branches already merged into HEAD"},
+	{Name: "no-merged", Description: "List only branches not yet merged into HEAD"},
+}
+
+// branchFlags declares every flag any `branch` subcommand accepts, since
+// validateFlags checks against the whole command rather than per
+// subcommand.
+var branchFlags = append(append([]FlagSpec{}, branchListFlags...), FlagSpec{
+	Name: "force", Shorthand: "f", Description: "Delete the branch even if it isn't fully merged",
+})
+
+// registerBranchCommands wires up `arngit branch`.
+func (r *Router) registerBranchCommands() {
+	r.registerCommand(Command{
+		Name:         "branch",
+		Handler:      handleBranch,
+		RequiresRepo: true,
+		Subcommands:  []string{"list", "delete", "prune"},
+		Flags:        branchFlags,
+	})
+}
+
+// handleBranch dispatches `arngit branch [list|delete]`, defaulting to list
+// with no subcommand.
+func handleBranch(ctx *Context) error {
+	if len(ctx.Args) == 0 {
+		return branchList(ctx, nil)
+	}
+
+	switch ctx.Args[0] {
+	case "list":
+		return branchList(ctx, ctx.Args[1:])
+	case "delete":
+		return branchDelete(ctx, ctx.Args[1:])
+	case "prune":
+		return branchPrune(ctx)
+	default:
+		// No explicit subcommand given; treat args as branchList's flags,
+		// so `arngit branch --merged` works like `arngit branch list --merged`.
+		return branchList(ctx, ctx.Args)
+	}
+}
+
+// branchList implements `branch list [--merged|--no-merged]`.
+func branchList(ctx *Context, args []string) error {
+	flags := parseFlags(branchListFlags, args)
+
+	var (
+		names []string
+		err   error
+	)
+	switch {
+	case flags.Bool["merged"]:
+		names, err = ctx.Git.MergedBranches("")
+	case flags.Bool["no-merged"]:
+		names, err = ctx.Git.UnmergedBranches("")
+	default:
+		names, err = ctx.Git.Branches()
+	}
+	if err != nil {
+		return err
+	}
+
+	for _, name := range names {
+		ctx.Renderer.Plain(name)
+	}
+	return nil
+}
+
+// branchDelete implements `branch delete <name> [--force]`. Deleting a
+// branch that isn't merged into HEAD requires --force (or --yes), so a
+// mistyped delete can't silently drop unmerged work.
+func branchDelete(ctx *Context, args []string) error {
+	if len(args) == 0 {
+		return fmt.Errorf("usage: arngit branch delete <name> [--force]")
+	}
+	name := args[0]
+	force := hasFlag(args, "--force") || hasFlag(args, "-f")
+
+	if !force {
+		merged, err := ctx.Git.MergedBranches("")
+		if err != nil {
+			return err
+		}
+		if !contains(merged, name) {
+			if !ctx.Confirm(fmt.Sprintf("branch %q is not fully merged into the current branch; delete it anyway?", name)) {
+				return fmt.Errorf("aborted: branch %q is not fully merged (pass --force to delete it anyway)", name)
+			}
+			force = true
+		}
+	}
+
+	out, err := ctx.Git.DeleteBranch(name, force)
+	if err != nil {
+		return err
+	}
+	ctx.Renderer.Success(fmt.Sprintf("deleted branch %q", name))
+	if out != "" {
+		ctx.Renderer.Plain(out)
+	}
+	return nil
+}
+
+// branchPrune lists local branches merged into the current branch (other
+// than the current branch itself) and, on confirmation, deletes all of
+// them.
+func branchPrune(ctx *Context) error {
+	current, err := ctx.Git.CurrentBranch()
+	if err != nil {
+		return err
+	}
+	merged, err := ctx.Git.MergedBranches("")
+	if err != nil {
+		return err
+	}
+
+	var candidates []string
+	for _, name := range merged {
+		if name != current {
+			candidates = append(candidates, name)
+		}
+	}
+	if len(candidates) == 0 {
+		ctx.Renderer.Success("no merged branches to prune")
+		return nil
+	}
+
+	ctx.Renderer.Plain(fmt.Sprintf("branches merged into %q:", current))
+	for _, name := range candidates {
+		ctx.Renderer.Plain("  " + name)
+	}
+	if !ctx.Confirm(fmt.Sprintf("delete these %d branch(es)?", len(candidates))) {
+		return nil
+	}
+
+	for _, name := range candidates {
+		if _, err := ctx.Git.DeleteBranch(name, false); err != nil {
+			return err
+		}
+		ctx.Renderer.Success(fmt.Sprintf("deleted branch %q", name))
+	}
+	return nil
+}
+
+func contains(items []string, target string) bool {
+	for _, item := range items {
+		if item == target {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,158 @@
+package command
+
+import (
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/buchorim/arngit/internal/core"
+)
+
+func setupBranchTestRepo(t *testing.T) string {
+	t.Helper()
+	if _, err := exec.LookPath("git"); err != nil {
+		t.Skip("git not installed")
+	}
+
+	dir := t.TempDir()
+	run := func(args ...string) {
+		cmd := exec.Command("git", args...)
+		cmd.Dir = dir
+		if out, err := cmd.CombinedOutput(); err != nil {
+			t.Fatalf("git %v: %v: %s", args, err, out)
+		}
+	}
+	run("init", "-q", "-b", "main")
+	run("config", "user.email", "test@example.com")
+	run("config", "user.name", "test")
+	if err := os.WriteFile(filepath.Join(dir, "f.txt"), []byte("hi"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	run("add", ".")
+	run("commit", "-q", "-m", "init")
+	run("branch", "merged-branch")
+	run("checkout", "-q", "-b", "unmerged-branch")
+	if err := os.WriteFile(filepath.Join(dir, "g.txt"), []byte("bye"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	run("add", ".")
+	run("commit", "-q", "-m", "second")
+	run("checkout", "-q", "main")
+
+	return dir
+}
+
+func TestBranchDeleteRequiresConfirmationForUnmergedBranch(t *testing.T) {
+	dir := setupBranchTestRepo(t)
+	chdir(t, dir)
+
+	readEnd, writeEnd, err := os.Pipe()
+	if err != nil {
+		t.Fatalf("Pipe: %v", err)
+	}
+	writeEnd.Close() // simulate EOF/decline
+	originalStdin := os.Stdin
+	os.Stdin = readEnd
+	defer func() { os.Stdin = originalStdin }()
+
+	router := NewRouter(&core.Engine{Config: core.DefaultConfig()}, "test")
+	err = router.Dispatch([]string{"branch", "delete", "unmerged-branch"})
+	if err == nil {
+		t.Fatal("expected deleting an unmerged branch to require confirmation and fail on decline")
+	}
+}
+
+func TestBranchDeleteMergedBranchNeedsNoConfirmation(t *testing.T) {
+	dir := setupBranchTestRepo(t)
+	chdir(t, dir)
+
+	router := NewRouter(&core.Engine{Config: core.DefaultConfig()}, "test")
+	if err := router.Dispatch([]string{"branch", "delete", "merged-branch"}); err != nil {
+		t.Fatalf("Dispatch: %v", err)
+	}
+}
+
+func TestBranchPruneDeclinesWithoutConfirmation(t *testing.T) {
+	dir := setupBranchTestRepo(t)
+	chdir(t, dir)
+
+	readEnd, writeEnd, err := os.Pipe()
+	if err != nil {
+		t.Fatalf("Pipe: %v", err)
+	}
+	writeEnd.Close()
+	originalStdin := os.Stdin
+	os.Stdin = readEnd
+	defer func() { os.Stdin = originalStdin }()
+
+	router := NewRouter(&core.Engine{Config: core.DefaultConfig()}, "test")
+	if err := router.Dispatch([]string{"branch", "prune"}); err != nil {
+		t.Fatalf("Dispatch: %v", err)
+	}
+
+	branches, err := gitBranches(dir)
+	if err != nil {
+		t.Fatalf("gitBranches: %v", err)
+	}
+	if !contains(branches, "merged-branch") {
+		t.Error("expected merged-branch to survive a declined prune")
+	}
+}
+
+func TestBranchPruneDeletesOnConfirmation(t *testing.T) {
+	dir := setupBranchTestRepo(t)
+	chdir(t, dir)
+
+	router := NewRouter(&core.Engine{Config: core.DefaultConfig()}, "test")
+	if err := router.Dispatch([]string{"branch", "prune", "--yes"}); err != nil {
+		t.Fatalf("Dispatch: %v", err)
+	}
+
+	branches, err := gitBranches(dir)
+	if err != nil {
+		t.Fatalf("gitBranches: %v", err)
+	}
+	if contains(branches, "merged-branch") {
+		t.Error("expected merged-branch to be pruned")
+	}
+	if !contains(branches, "unmerged-branch") {
+		t.Error("expected unmerged-branch to survive pruning")
+	}
+}
+
+func gitBranches(dir string) ([]string, error) {
+	cmd := exec.Command("git", "branch", "--format=%(refname:short)")
+	cmd.Dir = dir
+	out, err := cmd.Output()
+	if err != nil {
+		return nil, err
+	}
+
+	var names []string
+	for _, line := range strings.Split(string(out), "\n") {
+		line = strings.TrimSpace(line)
+		if line != "" {
+			names = append(names, line)
+		}
+	}
+	return names, nil
+}
+
+func TestBranchListMergedFilter(t *testing.T) {
+	dir := setupBranchTestRepo(t)
+	chdir(t, dir)
+
+	router := NewRouter(&core.Engine{Config: core.DefaultConfig()}, "test")
+
+	out := captureStdout(t, func() {
+		if err := router.Dispatch([]string{"branch", "list", "--no-merged"}); err != nil {
+			t.Fatalf("Dispatch: %v", err)
+		}
+	})
+
+	if out == "" {
+		t.Fatal("expected --no-merged to list the unmerged branch")
+	}
+}
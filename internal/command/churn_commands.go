@@ -0,0 +1,48 @@
+package command
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/buchorim/arngit/internal/analytics"
+)
+
+// registerChurnCommands wires up `arngit churn`.
+func (r *Router) registerChurnCommands() {
+	r.registerRepoCommand("churn", handleChurn)
+}
+
+// handleChurn implements `arngit churn [--since=DATE]`, listing the files
+// with the most insertions+deletions over the given (or full) history.
+func handleChurn(ctx *Context) error {
+	var since string
+	for _, a := range ctx.Args {
+		if strings.HasPrefix(a, "--since=") {
+			since = strings.TrimPrefix(a, "--since=")
+			continue
+		}
+		return fmt.Errorf("unknown churn flag: %s", a)
+	}
+
+	stats, err := analytics.GetChurn(ctx.Git, since)
+	if err != nil {
+		return err
+	}
+
+	files := make([]string, 0, len(stats.ByFile))
+	for f := range stats.ByFile {
+		files = append(files, f)
+	}
+	sort.Slice(files, func(i, j int) bool {
+		a, b := stats.ByFile[files[i]], stats.ByFile[files[j]]
+		return a.Insertions+a.Deletions > b.Insertions+b.Deletions
+	})
+
+	for _, f := range files {
+		fc := stats.ByFile[f]
+		ctx.Renderer.Plain(fmt.Sprintf("+%-6d -%-6d %s", fc.Insertions, fc.Deletions, f))
+	}
+	ctx.Renderer.Plain(fmt.Sprintf("total: +%d -%d", stats.Insertions, stats.Deletions))
+	return nil
+}
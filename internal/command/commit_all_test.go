@@ -0,0 +1,45 @@
+package command
+
+import (
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/buchorim/arngit/internal/core"
+)
+
+func TestCommitAllStagesModifiedButNotUntracked(t *testing.T) {
+	dir, _ := setupFixupTestRepo(t)
+
+	if err := os.WriteFile(filepath.Join(dir, "f.txt"), []byte("modified"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(filepath.Join(dir, "untracked.txt"), []byte("new"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	chdir(t, dir)
+	router := NewRouter(&core.Engine{Config: core.DefaultConfig()}, "test")
+
+	if err := router.Dispatch([]string{"commit", "-a", "-m", "stage modified only"}); err != nil {
+		t.Fatalf("commit -a: %v", err)
+	}
+
+	out, err := exec.Command("git", "-C", dir, "log", "-1", "--name-only", "--pretty=").CombinedOutput()
+	if err != nil {
+		t.Fatalf("log: %v: %s", err, out)
+	}
+	committed := strings.TrimSpace(string(out))
+	if committed != "f.txt" {
+		t.Fatalf("committed files = %q, want just f.txt", committed)
+	}
+
+	statusOut, err := exec.Command("git", "-C", dir, "status", "--porcelain").CombinedOutput()
+	if err != nil {
+		t.Fatalf("status: %v: %s", err, statusOut)
+	}
+	if !strings.Contains(string(statusOut), "?? untracked.txt") {
+		t.Fatalf("expected untracked.txt to remain untracked, status = %q", statusOut)
+	}
+}
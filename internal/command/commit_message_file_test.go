@@ -0,0 +1,79 @@
+package command
+
+import (
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/buchorim/arngit/internal/core"
+)
+
+func TestCommitMessageFromFile(t *testing.T) {
+	dir, _ := setupFixupTestRepo(t)
+
+	if err := os.WriteFile(filepath.Join(dir, "f.txt"), []byte("two"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	msgFile := filepath.Join(dir, "msg.txt")
+	if err := os.WriteFile(msgFile, []byte("commit from a file\n"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	chdir(t, dir)
+	router := NewRouter(&core.Engine{Config: core.DefaultConfig()}, "test")
+
+	if err := router.Dispatch([]string{"add", "."}); err != nil {
+		t.Fatalf("add: %v", err)
+	}
+	if err := router.Dispatch([]string{"commit", "-F", msgFile}); err != nil {
+		t.Fatalf("commit -F: %v", err)
+	}
+
+	out, err := exec.Command("git", "-C", dir, "log", "-1", "--pretty=%s").CombinedOutput()
+	if err != nil {
+		t.Fatalf("log: %v: %s", err, out)
+	}
+	if got, want := strings.TrimSpace(string(out)), "commit from a file"; got != want {
+		t.Fatalf("commit message = %q, want %q", got, want)
+	}
+}
+
+func TestCommitMessageFromStdin(t *testing.T) {
+	dir, _ := setupFixupTestRepo(t)
+
+	if err := os.WriteFile(filepath.Join(dir, "f.txt"), []byte("two"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	chdir(t, dir)
+	router := NewRouter(&core.Engine{Config: core.DefaultConfig()}, "test")
+
+	if err := router.Dispatch([]string{"add", "."}); err != nil {
+		t.Fatalf("add: %v", err)
+	}
+
+	originalStdin := os.Stdin
+	stdinR, stdinW, err := os.Pipe()
+	if err != nil {
+		t.Fatalf("Pipe: %v", err)
+	}
+	os.Stdin = stdinR
+	defer func() { os.Stdin = originalStdin }()
+
+	go func() {
+		stdinW.WriteString("commit from stdin\n")
+		stdinW.Close()
+	}()
+
+	if err := router.Dispatch([]string{"commit", "--message-file", "-"}); err != nil {
+		t.Fatalf("commit --message-file -: %v", err)
+	}
+
+	out, err := exec.Command("git", "-C", dir, "log", "-1", "--pretty=%s").CombinedOutput()
+	if err != nil {
+		t.Fatalf("log: %v: %s", err, out)
+	}
+	if got, want := strings.TrimSpace(string(out)), "commit from stdin"; got != want {
+		t.Fatalf("commit message = %q, want %q", got, want)
+	}
+}
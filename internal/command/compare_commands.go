@@ -0,0 +1,76 @@
+package command
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/buchorim/arngit/internal/git"
+)
+
+// registerCompareCommands wires up `arngit compare`.
+func (r *Router) registerCompareCommands() {
+	r.register("compare", handleCompare)
+}
+
+// handleCompare implements `arngit compare <base>..<head>` for the current
+// repo, or `arngit compare <owner/repo> <base>..<head>` against GitHub,
+// showing ahead/behind counts and the commits unique to head. This helps
+// before opening a PR.
+func handleCompare(ctx *Context) error {
+	switch len(ctx.Args) {
+	case 1:
+		return compareLocal(ctx, ctx.Args[0])
+	case 2:
+		return compareRemote(ctx, ctx.Args[0], ctx.Args[1])
+	default:
+		return fmt.Errorf("usage: arngit compare [<owner/repo>] <base>..<head>")
+	}
+}
+
+func compareLocal(ctx *Context, rangeArg string) error {
+	base, head := git.ParseDiffRefs([]string{rangeArg})
+	if head == "" {
+		return fmt.Errorf("usage: arngit compare <base>..<head>")
+	}
+
+	ahead, behind, commits, err := ctx.Git.CompareRefs(base, head)
+	if err != nil {
+		return err
+	}
+	renderCompare(ctx, base, head, ahead, behind, commits)
+	return nil
+}
+
+func compareRemote(ctx *Context, ownerRepoArg, rangeArg string) error {
+	owner, repo, err := splitOwnerRepo(ownerRepoArg)
+	if err != nil {
+		return err
+	}
+	base, head := git.ParseDiffRefs([]string{rangeArg})
+	if head == "" {
+		return fmt.Errorf("usage: arngit compare <owner/repo> <base>..<head>")
+	}
+
+	client, err := ctx.Engine.GitHubClient()
+	if err != nil {
+		return err
+	}
+	result, err := client.CompareCommits(owner, repo, base, head)
+	if err != nil {
+		return wrapGitHubError(err)
+	}
+
+	commits := make([]string, len(result.Commits))
+	for i, c := range result.Commits {
+		commits[i] = fmt.Sprintf("%s %s", c.SHA[:min(7, len(c.SHA))], strings.SplitN(c.Commit.Message, "\n", 2)[0])
+	}
+	renderCompare(ctx, base, head, result.AheadBy, result.BehindBy, commits)
+	return nil
+}
+
+func renderCompare(ctx *Context, base, head string, ahead, behind int, commits []string) {
+	ctx.Renderer.Plain(fmt.Sprintf("%s...%s: %d ahead, %d behind", base, head, ahead, behind))
+	for _, c := range commits {
+		ctx.Renderer.Plain(c)
+	}
+}
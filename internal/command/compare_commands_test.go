@@ -0,0 +1,75 @@
+package command
+
+import (
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/buchorim/arngit/internal/core"
+)
+
+// setupCompareTestRepo builds a repo with two diverging branches, "main"
+// and "feature", each one commit ahead of their common base.
+func setupCompareTestRepo(t *testing.T) string {
+	t.Helper()
+	if _, err := exec.LookPath("git"); err != nil {
+		t.Skip("git not installed")
+	}
+
+	dir := t.TempDir()
+	run := func(args ...string) {
+		cmd := exec.Command("git", args...)
+		cmd.Dir = dir
+		if out, err := cmd.CombinedOutput(); err != nil {
+			t.Fatalf("git %v: %v: %s", args, err, out)
+		}
+	}
+	write := func(name, content string) {
+		if err := os.WriteFile(filepath.Join(dir, name), []byte(content), 0o644); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	run("init", "-q", "-b", "main")
+	run("config", "user.email", "test@example.com")
+	run("config", "user.name", "test")
+	write("f.txt", "base")
+	run("add", ".")
+	run("commit", "-q", "-m", "base")
+
+	run("checkout", "-q", "-b", "feature")
+	write("g.txt", "feature")
+	run("add", ".")
+	run("commit", "-q", "-m", "feature change")
+
+	run("checkout", "-q", "main")
+	return dir
+}
+
+func TestCompareLocalShowsAheadBehind(t *testing.T) {
+	dir := setupCompareTestRepo(t)
+	chdir(t, dir)
+
+	router := NewRouter(&core.Engine{Config: core.DefaultConfig()}, "test")
+	out := captureStdout(t, func() {
+		if err := router.Dispatch([]string{"compare", "main..feature"}); err != nil {
+			t.Fatalf("Dispatch: %v", err)
+		}
+	})
+	if !strings.Contains(out, "1 ahead, 0 behind") {
+		t.Errorf("output = %q", out)
+	}
+	if !strings.Contains(out, "feature change") {
+		t.Errorf("output missing commit summary: %q", out)
+	}
+}
+
+func TestCompareRejectsWrongArgCount(t *testing.T) {
+	router := NewRouter(&core.Engine{Config: core.DefaultConfig()}, "test")
+	err := router.Dispatch([]string{"compare"})
+	if err == nil {
+		t.Fatal("expected an error with no arguments")
+	}
+}
@@ -0,0 +1,41 @@
+package command
+
+import "github.com/chzyer/readline"
+
+// completer builds a readline.AutoCompleter over router's registered
+// commands and their known subcommands, so the interactive REPL can
+// tab-complete both the top-level command and, where applicable, its
+// first argument.
+func (r *Router) completer() readline.AutoCompleter {
+	items := make([]readline.PrefixCompleterInterface, 0, len(r.handlers))
+	for name, cmd := range r.handlers {
+		items = append(items, readline.PcItem(name, subcompleters(name, cmd.Subcommands)...))
+	}
+	return readline.NewPrefixCompleter(items...)
+}
+
+// subcompleters turns a command's subcommand names into completer items,
+// nesting the config keys and profile subcommands one level deeper for
+// "config set" and "config profile" respectively.
+func subcompleters(name string, subcommands []string) []readline.PrefixCompleterInterface {
+	items := make([]readline.PrefixCompleterInterface, 0, len(subcommands))
+	for _, sub := range subcommands {
+		switch {
+		case name == "config" && sub == "set":
+			items = append(items, readline.PcItem(sub, keyCompleters(configKeys)...))
+		case name == "config" && sub == "profile":
+			items = append(items, readline.PcItem(sub, keyCompleters([]string{"list", "use", "create"})...))
+		default:
+			items = append(items, readline.PcItem(sub))
+		}
+	}
+	return items
+}
+
+func keyCompleters(keys []string) []readline.PrefixCompleterInterface {
+	items := make([]readline.PrefixCompleterInterface, 0, len(keys))
+	for _, k := range keys {
+		items = append(items, readline.PcItem(k))
+	}
+	return items
+}
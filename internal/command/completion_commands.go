@@ -0,0 +1,144 @@
+package command
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// registerCompletionCommands wires up `arngit completion`.
+func (r *Router) registerCompletionCommands() {
+	r.register("completion", handleCompletion)
+}
+
+// handleCompletion implements `arngit completion <bash|zsh|fish|powershell>`,
+// generating a shell completion script from the router's own command and
+// subcommand tree so it can never drift out of sync with what's registered.
+func handleCompletion(ctx *Context) error {
+	if len(ctx.Args) != 1 {
+		return fmt.Errorf("usage: arngit completion <bash|zsh|fish|powershell>")
+	}
+
+	names, subcommands := ctx.router.commandTree()
+
+	var script string
+	switch ctx.Args[0] {
+	case "bash":
+		script = bashCompletionScript(names, subcommands)
+	case "zsh":
+		script = zshCompletionScript(names, subcommands)
+	case "fish":
+		script = fishCompletionScript(names, subcommands)
+	case "powershell":
+		script = powershellCompletionScript(names, subcommands)
+	default:
+		return fmt.Errorf("unsupported shell: %s (want bash, zsh, fish, or powershell)", ctx.Args[0])
+	}
+
+	ctx.Renderer.Plain(script)
+	return nil
+}
+
+// commandTree returns the sorted top-level command names and each one's
+// registered subcommands (see Command.Subcommands).
+func (r *Router) commandTree() ([]string, map[string][]string) {
+	names := make([]string, 0, len(r.handlers))
+	subcommands := make(map[string][]string, len(r.handlers))
+	for name, cmd := range r.handlers {
+		names = append(names, name)
+		if len(cmd.Subcommands) > 0 {
+			subcommands[name] = cmd.Subcommands
+		}
+	}
+	sort.Strings(names)
+	return names, subcommands
+}
+
+func bashCompletionScript(names []string, subcommands map[string][]string) string {
+	var b strings.Builder
+	fmt.Fprintln(&b, "# bash completion for arngit")
+	fmt.Fprintln(&b, "_arngit_completions() {")
+	fmt.Fprintln(&b, `  local cur prev words cword`)
+	fmt.Fprintln(&b, `  _init_completion || return`)
+	fmt.Fprintf(&b, "  local commands=\"%s\"\n", strings.Join(names, " "))
+	fmt.Fprintln(&b, `  if [ "$cword" -eq 1 ]; then`)
+	fmt.Fprintln(&b, `    COMPREPLY=( $(compgen -W "$commands" -- "$cur") )`)
+	fmt.Fprintln(&b, `    return`)
+	fmt.Fprintln(&b, `  fi`)
+	fmt.Fprintln(&b, `  case "${words[1]}" in`)
+	for _, name := range names {
+		subs := subcommands[name]
+		if len(subs) == 0 {
+			continue
+		}
+		fmt.Fprintf(&b, "    %s)\n", name)
+		fmt.Fprintf(&b, "      COMPREPLY=( $(compgen -W \"%s\" -- \"$cur\") )\n", strings.Join(subs, " "))
+		fmt.Fprintln(&b, "      ;;")
+	}
+	fmt.Fprintln(&b, `  esac`)
+	fmt.Fprintln(&b, "}")
+	fmt.Fprintln(&b, "complete -F _arngit_completions arngit")
+	return b.String()
+}
+
+func zshCompletionScript(names []string, subcommands map[string][]string) string {
+	var b strings.Builder
+	fmt.Fprintln(&b, "#compdef arngit")
+	fmt.Fprintln(&b, "_arngit() {")
+	fmt.Fprintf(&b, "  local -a commands=(%s)\n", strings.Join(names, " "))
+	fmt.Fprintln(&b, `  if (( CURRENT == 2 )); then`)
+	fmt.Fprintln(&b, `    _describe 'command' commands`)
+	fmt.Fprintln(&b, `    return`)
+	fmt.Fprintln(&b, `  fi`)
+	fmt.Fprintln(&b, `  case "${words[2]}" in`)
+	for _, name := range names {
+		subs := subcommands[name]
+		if len(subs) == 0 {
+			continue
+		}
+		fmt.Fprintf(&b, "    %s) _values 'subcommand' %s ;;\n", name, quoteAll(subs))
+	}
+	fmt.Fprintln(&b, `  esac`)
+	fmt.Fprintln(&b, "}")
+	fmt.Fprintln(&b, "_arngit")
+	return b.String()
+}
+
+func fishCompletionScript(names []string, subcommands map[string][]string) string {
+	var b strings.Builder
+	for _, name := range names {
+		fmt.Fprintf(&b, "complete -c arngit -n '__fish_use_subcommand' -a %s\n", name)
+		for _, sub := range subcommands[name] {
+			fmt.Fprintf(&b, "complete -c arngit -n '__fish_seen_subcommand_from %s' -a %s\n", name, sub)
+		}
+	}
+	return b.String()
+}
+
+func powershellCompletionScript(names []string, subcommands map[string][]string) string {
+	var b strings.Builder
+	fmt.Fprintln(&b, "Register-ArgumentCompleter -Native -CommandName arngit -ScriptBlock {")
+	fmt.Fprintln(&b, "  param($wordToComplete, $commandAst, $cursorPosition)")
+	fmt.Fprintf(&b, "  $commands = @(%s)\n", quoteAllPowershell(names))
+	fmt.Fprintln(&b, "  $commands | Where-Object { $_ -like \"$wordToComplete*\" } | ForEach-Object {")
+	fmt.Fprintln(&b, "    [System.Management.Automation.CompletionResult]::new($_, $_, 'ParameterValue', $_)")
+	fmt.Fprintln(&b, "  }")
+	fmt.Fprintln(&b, "}")
+	return b.String()
+}
+
+func quoteAll(items []string) string {
+	quoted := make([]string, len(items))
+	for i, item := range items {
+		quoted[i] = "'" + item + "'"
+	}
+	return strings.Join(quoted, " ")
+}
+
+func quoteAllPowershell(items []string) string {
+	quoted := make([]string, len(items))
+	for i, item := range items {
+		quoted[i] = "'" + item + "'"
+	}
+	return strings.Join(quoted, ",")
+}
@@ -0,0 +1,29 @@
+package command
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/buchorim/arngit/internal/core"
+)
+
+func TestBashCompletionScriptReferencesTopLevelCommands(t *testing.T) {
+	router := NewRouter(&core.Engine{Config: core.DefaultConfig()}, "test")
+	names, subcommands := router.commandTree()
+
+	script := bashCompletionScript(names, subcommands)
+
+	for _, name := range []string{"status", "commit", "account", "config"} {
+		if !strings.Contains(script, name) {
+			t.Errorf("bash completion script missing command %q", name)
+		}
+	}
+}
+
+func TestHandleCompletionRejectsUnknownShell(t *testing.T) {
+	router := NewRouter(&core.Engine{Config: core.DefaultConfig()}, "test")
+	err := router.Dispatch([]string{"completion", "csh"})
+	if err == nil {
+		t.Fatal("expected an error for an unsupported shell")
+	}
+}
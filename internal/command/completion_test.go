@@ -0,0 +1,42 @@
+package command
+
+import (
+	"testing"
+
+	"github.com/buchorim/arngit/internal/core"
+)
+
+func TestCompleterCompletesTopLevelCommands(t *testing.T) {
+	router := NewRouter(&core.Engine{Config: core.DefaultConfig()}, "test")
+
+	candidates, length := router.completer().Do([]rune("stat"), len("stat"))
+	if length != len("stat") {
+		t.Fatalf("length = %d, want %d", length, len("stat"))
+	}
+
+	found := false
+	for _, c := range candidates {
+		if string(c) == "us " {
+			found = true
+		}
+	}
+	if !found {
+		t.Errorf("candidates = %v, want completion of \"status\" (suffix \"us \")", candidates)
+	}
+}
+
+func TestCompleterCompletesSubcommands(t *testing.T) {
+	router := NewRouter(&core.Engine{Config: core.DefaultConfig()}, "test")
+
+	candidates, _ := router.completer().Do([]rune("account a"), len("account a"))
+
+	found := false
+	for _, c := range candidates {
+		if string(c) == "dd " {
+			found = true
+		}
+	}
+	if !found {
+		t.Errorf("candidates = %v, want completion of \"add\" (suffix \"dd \")", candidates)
+	}
+}
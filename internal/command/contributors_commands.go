@@ -0,0 +1,36 @@
+package command
+
+import (
+	"fmt"
+
+	"github.com/buchorim/arngit/internal/analytics"
+)
+
+// registerContributorsCommands wires up `arngit contributors`.
+func (r *Router) registerContributorsCommands() {
+	r.registerRepoCommand("contributors", handleContributors)
+}
+
+// handleContributors implements `arngit contributors [--no-mailmap]`,
+// listing deduplicated authors with their commit count, first/last commit,
+// and share of total commits.
+func handleContributors(ctx *Context) error {
+	useMailmap := true
+	for _, a := range ctx.Args {
+		if a == "--no-mailmap" {
+			useMailmap = false
+		}
+	}
+
+	contributors, err := analytics.GetContributors(ctx.Git, useMailmap)
+	if err != nil {
+		return err
+	}
+
+	for _, c := range contributors {
+		ctx.Renderer.Plain(fmt.Sprintf("%-25s %5d commits  %5.1f%%  %s -> %s",
+			c.Name, c.Count, c.Percent,
+			c.First.Format("2006-01-02"), c.Last.Format("2006-01-02")))
+	}
+	return nil
+}
@@ -0,0 +1,153 @@
+package command
+
+import (
+	"fmt"
+	"math/rand"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/buchorim/arngit/internal/git"
+	"github.com/buchorim/arngit/internal/ui"
+)
+
+// dashboardRand backs dashboardGreeting's random mode. Seeded once at
+// package init rather than per-call, since Go 1.20+ auto-seeds the global
+// source anyway and reseeding on every render was never buying anything.
+var dashboardRand = rand.New(rand.NewSource(time.Now().UnixNano()))
+
+// dashboardGreetings are the lines dashboardGreeting picks from in "random"
+// mode. Index 0 is also what "fixed" mode always returns.
+var dashboardGreetings = []string{
+	"Ready when you are.",
+	"Let's ship something.",
+	"Good to see you back.",
+	"What are we building today?",
+}
+
+// dashboardGreeting returns a greeting line per the dashboard_greeting
+// config value: always the first one for "fixed", a random one otherwise.
+func dashboardGreeting(mode string) string {
+	if mode == "fixed" {
+		return dashboardGreetings[0]
+	}
+	return dashboardGreetings[dashboardRand.Intn(len(dashboardGreetings))]
+}
+
+// ShowDashboard prints a short repository overview for the current working
+// directory: a greeting, the branch (or a fresh-repo notice), and the
+// latest commit, if any. It's silent outside a git repository, since
+// RunInteractive calls it unconditionally at startup regardless of where
+// arngit was launched.
+func (r *Router) ShowDashboard() {
+	cwd, err := os.Getwd()
+	if err != nil {
+		return
+	}
+
+	gitSvc := git.NewService(cwd)
+	if !gitSvc.IsRepo() {
+		return
+	}
+
+	renderer := ui.NewRenderer(ui.ResolveColor(nil, r.engine.Config.ColorOutput, ui.IsTTY(os.Stdout)), r.engine.Config.Theme)
+	renderer.ASCIIOnly = ui.DetectASCIIOnly(r.engine.Config.ASCIIOnly)
+
+	// compact_mode drops the greeting and prints just the repo summary, for
+	// users who want the dashboard out of their way.
+	if !r.engine.Config.CompactMode {
+		renderer.Title(dashboardGreeting(r.engine.Config.DashboardGreeting))
+	}
+	renderer.Plain(getDashboardRepoInfo(gitSvc))
+	if label := dashboardRepoLabel(gitSvc); label != "" {
+		renderer.Plain(label)
+	}
+	if summary := dashboardAheadBehind(gitSvc); summary != "" {
+		renderer.Plain(summary)
+	}
+	if summary := dashboardStashSummary(gitSvc); summary != "" {
+		renderer.Plain(summary)
+	}
+}
+
+// dashboardAheadBehind renders how far the current branch has diverged from
+// its upstream, or "" if there's no upstream to compare against.
+func dashboardAheadBehind(gitSvc *git.Service) string {
+	ahead, behind, ok, err := gitSvc.AheadBehind()
+	if err != nil || !ok || (ahead == 0 && behind == 0) {
+		return ""
+	}
+	switch {
+	case ahead > 0 && behind > 0:
+		return fmt.Sprintf("%d ahead, %d behind", ahead, behind)
+	case ahead > 0:
+		return fmt.Sprintf("%d ahead", ahead)
+	default:
+		return fmt.Sprintf("%d behind", behind)
+	}
+}
+
+// dashboardStashSummary renders the stash count, or "" if there's nothing
+// stashed (the common case, not worth a line of its own).
+func dashboardStashSummary(gitSvc *git.Service) string {
+	stashes, err := gitSvc.StashList()
+	if err != nil || len(stashes) == 0 {
+		return ""
+	}
+	word := "stash"
+	if len(stashes) != 1 {
+		word = "stashes"
+	}
+	return fmt.Sprintf("%d %s", len(stashes), word)
+}
+
+// dashboardRepoLabel returns the "owner/repo" shorthand for the origin
+// remote, or "" if there is no origin or its URL doesn't parse (e.g. a
+// local-only repo with no remote configured).
+func dashboardRepoLabel(gitSvc *git.Service) string {
+	remote, err := gitSvc.RemoteURL("origin")
+	if err != nil {
+		return ""
+	}
+	_, owner, repo, err := git.ParseRemoteURL(remote)
+	if err != nil {
+		return ""
+	}
+	return fmt.Sprintf("%s/%s", owner, repo)
+}
+
+// getDashboardRepoInfo renders the branch/commit summary line ShowDashboard
+// prints, tolerating a repo with no commits yet (an unborn HEAD) instead of
+// erroring.
+func getDashboardRepoInfo(gitSvc *git.Service) string {
+	branch, err := gitSvc.CurrentBranch()
+	if err != nil {
+		branch = "unknown"
+	}
+
+	if !gitSvc.HasCommits() {
+		return fmt.Sprintf("on %s - no commits yet", branch)
+	}
+
+	out, err := gitSvc.LogFormat("%H %s", 1)
+	if err != nil || strings.TrimSpace(out) == "" {
+		return fmt.Sprintf("on %s", branch)
+	}
+	out = strings.TrimSpace(out)
+
+	hash, message, ok := strings.Cut(out, " ")
+	if !ok {
+		return fmt.Sprintf("on %s - %s", branch, shortHash(out))
+	}
+	return fmt.Sprintf("on %s - %s %s", branch, shortHash(hash), message)
+}
+
+// shortHash truncates h to a display-friendly 7 characters, safely
+// returning h unchanged if it's already shorter (e.g. an empty or
+// unexpectedly abbreviated hash) rather than panicking on the slice.
+func shortHash(h string) string {
+	if len(h) <= 7 {
+		return h
+	}
+	return h[:7]
+}
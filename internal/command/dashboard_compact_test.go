@@ -0,0 +1,55 @@
+package command
+
+import (
+	"os/exec"
+	"strings"
+	"testing"
+
+	"github.com/buchorim/arngit/internal/core"
+)
+
+func TestShowDashboardCompactModeOmitsGreeting(t *testing.T) {
+	if _, err := exec.LookPath("git"); err != nil {
+		t.Skip("git not installed")
+	}
+
+	dir := t.TempDir()
+	if out, err := exec.Command("git", "init", "-q", dir).CombinedOutput(); err != nil {
+		t.Fatalf("git init: %v: %s", err, out)
+	}
+	chdir(t, dir)
+
+	cfg := core.DefaultConfig()
+	cfg.CompactMode = true
+	router := NewRouter(&core.Engine{Config: cfg}, "test")
+
+	out := captureStdout(t, func() {
+		router.ShowDashboard()
+	})
+
+	if strings.Count(out, "\n") != 1 {
+		t.Fatalf("expected exactly one line of compact dashboard output, got %q", out)
+	}
+}
+
+func TestShowDashboardNonCompactModeIncludesGreeting(t *testing.T) {
+	if _, err := exec.LookPath("git"); err != nil {
+		t.Skip("git not installed")
+	}
+
+	dir := t.TempDir()
+	if out, err := exec.Command("git", "init", "-q", dir).CombinedOutput(); err != nil {
+		t.Fatalf("git init: %v: %s", err, out)
+	}
+	chdir(t, dir)
+
+	router := NewRouter(&core.Engine{Config: core.DefaultConfig()}, "test")
+
+	out := captureStdout(t, func() {
+		router.ShowDashboard()
+	})
+
+	if strings.Count(out, "\n") != 2 {
+		t.Fatalf("expected two lines (greeting + repo info), got %q", out)
+	}
+}
@@ -0,0 +1,157 @@
+package command
+
+import (
+	"os"
+	"os/exec"
+	"path/filepath"
+	"testing"
+
+	"github.com/buchorim/arngit/internal/git"
+)
+
+func TestGetDashboardRepoInfoHandlesUnbornBranch(t *testing.T) {
+	if _, err := exec.LookPath("git"); err != nil {
+		t.Skip("git not installed")
+	}
+
+	dir := t.TempDir()
+	if out, err := exec.Command("git", "init", "-q", dir).CombinedOutput(); err != nil {
+		t.Fatalf("git init: %v: %s", err, out)
+	}
+
+	gitSvc := git.NewService(dir)
+	info := getDashboardRepoInfo(gitSvc)
+
+	if info == "" {
+		t.Fatal("expected non-empty dashboard info for a fresh repo")
+	}
+}
+
+func TestDashboardRepoLabelFromOrigin(t *testing.T) {
+	if _, err := exec.LookPath("git"); err != nil {
+		t.Skip("git not installed")
+	}
+
+	dir := t.TempDir()
+	if out, err := exec.Command("git", "init", "-q", dir).CombinedOutput(); err != nil {
+		t.Fatalf("git init: %v: %s", err, out)
+	}
+	gitSvc := git.NewService(dir)
+	if out, err := exec.Command("git", "-C", dir, "remote", "add", "origin", "git@github.com:owner/repo.git").CombinedOutput(); err != nil {
+		t.Fatalf("git remote add: %v: %s", err, out)
+	}
+
+	if got, want := dashboardRepoLabel(gitSvc), "owner/repo"; got != want {
+		t.Errorf("dashboardRepoLabel() = %q, want %q", got, want)
+	}
+}
+
+func TestDashboardRepoLabelEmptyWithoutOrigin(t *testing.T) {
+	if _, err := exec.LookPath("git"); err != nil {
+		t.Skip("git not installed")
+	}
+
+	dir := t.TempDir()
+	if out, err := exec.Command("git", "init", "-q", dir).CombinedOutput(); err != nil {
+		t.Fatalf("git init: %v: %s", err, out)
+	}
+
+	if got := dashboardRepoLabel(git.NewService(dir)); got != "" {
+		t.Errorf("dashboardRepoLabel() = %q, want empty string without an origin remote", got)
+	}
+}
+
+func TestDashboardAheadBehindWithoutUpstream(t *testing.T) {
+	if _, err := exec.LookPath("git"); err != nil {
+		t.Skip("git not installed")
+	}
+
+	dir := t.TempDir()
+	if out, err := exec.Command("git", "init", "-q", dir).CombinedOutput(); err != nil {
+		t.Fatalf("git init: %v: %s", err, out)
+	}
+
+	if got := dashboardAheadBehind(git.NewService(dir)); got != "" {
+		t.Errorf("dashboardAheadBehind() = %q, want empty string without an upstream", got)
+	}
+}
+
+func TestDashboardAheadBehindAndStashCount(t *testing.T) {
+	if _, err := exec.LookPath("git"); err != nil {
+		t.Skip("git not installed")
+	}
+
+	origin := t.TempDir()
+	if out, err := exec.Command("git", "init", "-q", "-b", "main", "--bare", origin).CombinedOutput(); err != nil {
+		t.Fatalf("git init --bare: %v: %s", err, out)
+	}
+
+	dir := t.TempDir()
+	run := func(args ...string) {
+		cmd := exec.Command("git", args...)
+		cmd.Dir = dir
+		if out, err := cmd.CombinedOutput(); err != nil {
+			t.Fatalf("git %v: %v: %s", args, err, out)
+		}
+	}
+	run("clone", "-q", origin, ".")
+	run("config", "user.email", "test@example.com")
+	run("config", "user.name", "test")
+	run("commit", "-q", "--allow-empty", "-m", "base")
+	run("push", "-q", "origin", "main")
+
+	run("commit", "-q", "--allow-empty", "-m", "ahead one")
+	run("commit", "-q", "--allow-empty", "-m", "ahead two")
+
+	if err := os.WriteFile(filepath.Join(dir, "dirty.txt"), []byte("wip\n"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	run("add", "dirty.txt")
+	run("stash", "-q")
+
+	gitSvc := git.NewService(dir)
+	if got, want := dashboardAheadBehind(gitSvc), "2 ahead"; got != want {
+		t.Errorf("dashboardAheadBehind() = %q, want %q", got, want)
+	}
+	if got, want := dashboardStashSummary(gitSvc), "1 stash"; got != want {
+		t.Errorf("dashboardStashSummary() = %q, want %q", got, want)
+	}
+}
+
+func TestShowDashboardDoesNotPanicOutsideRepo(t *testing.T) {
+	dir := t.TempDir()
+	chdir(t, dir)
+
+	router := &Router{}
+	router.ShowDashboard()
+}
+
+func TestDashboardGreetingFixedModeIsStable(t *testing.T) {
+	first := dashboardGreeting("fixed")
+	for i := 0; i < 10; i++ {
+		if got := dashboardGreeting("fixed"); got != first {
+			t.Fatalf("dashboardGreeting(\"fixed\") = %q on call %d, want stable %q", got, i, first)
+		}
+	}
+}
+
+func TestShortHash(t *testing.T) {
+	tests := []struct {
+		name string
+		in   string
+		want string
+	}{
+		{"full hash", "abc1234567890", "abc1234"},
+		{"short hash", "abc", "abc"},
+		{"empty hash", "", ""},
+		{"exactly seven", "abc1234", "abc1234"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := shortHash(tt.in); got != tt.want {
+				t.Errorf("shortHash(%q) = %q, want %q", tt.in, got, tt.want)
+			}
+		})
+	}
+}
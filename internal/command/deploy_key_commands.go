@@ -0,0 +1,147 @@
+package command
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"github.com/buchorim/arngit/internal/github"
+)
+
+// sshKeyTypes are the algorithm prefixes an SSH public key line can start
+// with, per the OpenSSH key formats GitHub accepts as deploy keys.
+var sshKeyTypes = []string{"ssh-rsa", "ssh-ed25519", "ssh-dss", "ecdsa-sha2-nistp256", "ecdsa-sha2-nistp384", "ecdsa-sha2-nistp521"}
+
+// deployKeyFlags declares the flags `arngit deploy-key add` accepts.
+var deployKeyFlags = []FlagSpec{
+	{Name: "read-only", Description: "Restrict the deploy key to pulling, not pushing"},
+	{Name: "title", Description: "Label shown for the deploy key (default: the key file's name)", TakesValue: true},
+}
+
+// registerDeployKeyCommands wires up `arngit deploy-key`.
+func (r *Router) registerDeployKeyCommands() {
+	r.registerCommand(Command{
+		Name:            "deploy-key",
+		Handler:         handleDeployKey,
+		RequiresRepo:    true,
+		RequiresAccount: true,
+		Subcommands:     []string{"list", "add", "remove"},
+		Flags:           deployKeyFlags,
+	})
+}
+
+// handleDeployKey dispatches `arngit deploy-key <list|add|remove>`.
+func handleDeployKey(ctx *Context) error {
+	if len(ctx.Args) == 0 {
+		return fmt.Errorf("usage: arngit deploy-key <list|add <public-key-file>|remove <id>>")
+	}
+	sub, rest := ctx.Args[0], ctx.Args[1:]
+
+	owner, repo, err := currentOwnerRepo(ctx)
+	if err != nil {
+		return err
+	}
+	client, err := ctx.Engine.GitHubClient()
+	if err != nil {
+		return err
+	}
+
+	switch sub {
+	case "list":
+		return deployKeyList(ctx, client, owner, repo)
+	case "add":
+		return deployKeyAdd(ctx, client, owner, repo, rest)
+	case "remove":
+		return deployKeyRemove(ctx, client, owner, repo, rest)
+	default:
+		return fmt.Errorf("unknown deploy-key subcommand: %s", sub)
+	}
+}
+
+func deployKeyList(ctx *Context, client *github.Client, owner, repo string) error {
+	keys, err := client.ListDeployKeys(owner, repo)
+	if err != nil {
+		return wrapGitHubError(err)
+	}
+	if len(keys) == 0 {
+		ctx.Renderer.Plain("no deploy keys configured")
+		return nil
+	}
+	for _, k := range keys {
+		ctx.Renderer.Plain(fmt.Sprintf("%d  %s  read_only=%t", k.ID, k.Title, k.ReadOnly))
+	}
+	return nil
+}
+
+// validateSSHPublicKey requires key to start with a recognized OpenSSH
+// public key algorithm prefix, catching an accidental private key or
+// non-key file before it reaches the API.
+func validateSSHPublicKey(key string) error {
+	fields := strings.Fields(key)
+	if len(fields) < 2 {
+		return fmt.Errorf("doesn't look like an SSH public key")
+	}
+	for _, prefix := range sshKeyTypes {
+		if fields[0] == prefix {
+			return nil
+		}
+	}
+	return fmt.Errorf("unrecognized SSH key type %q", fields[0])
+}
+
+func deployKeyAdd(ctx *Context, client *github.Client, owner, repo string, args []string) error {
+	flags := parseFlags(deployKeyFlags, args)
+	if len(flags.Positional) != 1 {
+		return fmt.Errorf("usage: arngit deploy-key add <public-key-file> [--read-only] [--title=name]")
+	}
+	path := flags.Positional[0]
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return fmt.Errorf("reading %s: %w", path, err)
+	}
+	key := strings.TrimSpace(string(data))
+	if err := validateSSHPublicKey(key); err != nil {
+		return fmt.Errorf("%s: %w", path, err)
+	}
+
+	title := flags.Value["title"]
+	if title == "" {
+		title = filepath.Base(path)
+	}
+	readOnly := flags.Bool["read-only"]
+
+	if ctx.DryRun {
+		ctx.Renderer.Info(fmt.Sprintf("dry run: would add deploy key %q (read_only=%t)", title, readOnly))
+		return nil
+	}
+
+	added, err := client.AddDeployKey(owner, repo, title, key, readOnly)
+	if err != nil {
+		return wrapGitHubError(err)
+	}
+	ctx.Renderer.Success(fmt.Sprintf("added deploy key %d (%s)", added.ID, added.Title))
+	return nil
+}
+
+func deployKeyRemove(ctx *Context, client *github.Client, owner, repo string, args []string) error {
+	if len(args) != 1 {
+		return fmt.Errorf("usage: arngit deploy-key remove <id>")
+	}
+	id, err := strconv.ParseInt(args[0], 10, 64)
+	if err != nil {
+		return fmt.Errorf("invalid deploy key id %q: %w", args[0], err)
+	}
+
+	if ctx.DryRun {
+		ctx.Renderer.Info(fmt.Sprintf("dry run: would remove deploy key %d", id))
+		return nil
+	}
+	if err := client.DeleteDeployKey(owner, repo, id); err != nil {
+		return wrapGitHubError(err)
+	}
+	ctx.Renderer.Success(fmt.Sprintf("removed deploy key %d", id))
+	return nil
+}
@@ -0,0 +1,85 @@
+package command
+
+import (
+	"errors"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"testing"
+
+	"github.com/buchorim/arngit/internal/core"
+)
+
+func TestValidateSSHPublicKey(t *testing.T) {
+	tests := []struct {
+		name    string
+		key     string
+		wantErr bool
+	}{
+		{"ed25519", "ssh-ed25519 AAAAC3NzaC1lZDI1NTE5AAAA comment", false},
+		{"rsa", "ssh-rsa AAAAB3NzaC1yc2EAAAA comment", false},
+		{"no comment still valid", "ssh-ed25519 AAAAC3NzaC1lZDI1NTE5AAAA", false},
+		{"unrecognized type", "not-a-key AAAA", true},
+		{"single field", "ssh-ed25519", true},
+		{"empty", "", true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := validateSSHPublicKey(tt.key)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("validateSSHPublicKey(%q) error = %v, wantErr %v", tt.key, err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestDeployKeyAddRejectsInvalidKeyFile(t *testing.T) {
+	if _, err := exec.LookPath("git"); err != nil {
+		t.Skip("git not installed")
+	}
+	dir := t.TempDir()
+	if out, err := exec.Command("git", "init", "-q", dir).CombinedOutput(); err != nil {
+		t.Fatalf("git init: %v: %s", err, out)
+	}
+	if out, err := exec.Command("git", "-C", dir, "remote", "add", "origin", "git@github.com:acme/widgets.git").CombinedOutput(); err != nil {
+		t.Fatalf("git remote add: %v: %s", err, out)
+	}
+	chdir(t, dir)
+
+	keyPath := filepath.Join(dir, "id_ed25519.pub")
+	if err := os.WriteFile(keyPath, []byte("not a key\n"), 0o644); err != nil {
+		t.Fatalf("WriteFile: %v", err)
+	}
+
+	router := NewRouter(&core.Engine{Config: core.DefaultConfig(), Accounts: &core.AccountStore{Active: "work"}}, "test")
+	err := router.Dispatch([]string{"deploy-key", "add", keyPath})
+	if err == nil {
+		t.Fatal("expected an error for an invalid deploy key file")
+	}
+}
+
+func TestDeployKeyRequiresAccountDeclinesWithoutAccount(t *testing.T) {
+	if _, err := exec.LookPath("git"); err != nil {
+		t.Skip("git not installed")
+	}
+	dir := t.TempDir()
+	if out, err := exec.Command("git", "init", "-q", dir).CombinedOutput(); err != nil {
+		t.Fatalf("git init: %v: %s", err, out)
+	}
+	chdir(t, dir)
+
+	readEnd, writeEnd, err := os.Pipe()
+	if err != nil {
+		t.Fatalf("Pipe: %v", err)
+	}
+	writeEnd.Close()
+	originalStdin := os.Stdin
+	os.Stdin = readEnd
+	defer func() { os.Stdin = originalStdin }()
+
+	router := NewRouter(&core.Engine{Config: core.DefaultConfig(), Accounts: &core.AccountStore{}}, "test")
+	err = router.Dispatch([]string{"deploy-key", "list"})
+	if !errors.Is(err, core.ErrNoAccount) {
+		t.Errorf("err = %v, want ErrNoAccount", err)
+	}
+}
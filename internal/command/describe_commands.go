@@ -0,0 +1,30 @@
+package command
+
+import "strings"
+
+var describeFlags = []FlagSpec{
+	{Name: "dirty", Description: "Append -dirty if the working tree has uncommitted changes"},
+}
+
+// registerDescribeCommands wires up `arngit describe`.
+func (r *Router) registerDescribeCommands() {
+	r.registerCommand(Command{
+		Name:         "describe",
+		Handler:      handleDescribe,
+		RequiresRepo: true,
+		Flags:        describeFlags,
+	})
+}
+
+// handleDescribe prints the nearest tag's descriptive name for HEAD.
+func handleDescribe(ctx *Context) error {
+	flags := parseFlags(describeFlags, ctx.Args)
+
+	out, err := ctx.Git.Describe(flags.Bool["dirty"])
+	if err != nil {
+		return err
+	}
+
+	ctx.Renderer.Plain(strings.TrimSpace(out))
+	return nil
+}
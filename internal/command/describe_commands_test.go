@@ -0,0 +1,22 @@
+package command
+
+import "testing"
+
+func TestParseFlagsDescribeDirty(t *testing.T) {
+	flags := parseFlags(describeFlags, []string{"--dirty"})
+
+	if !flags.Bool["dirty"] {
+		t.Fatal("expected --dirty to be recognized")
+	}
+	if len(flags.Positional) != 0 {
+		t.Fatalf("expected no positional args, got %v", flags.Positional)
+	}
+}
+
+func TestDispatchRejectsUnknownDescribeFlag(t *testing.T) {
+	cmd := Command{Name: "describe", Handler: handleDescribe, Flags: describeFlags}
+
+	if err := validateFlags(cmd, []string{"--dirt"}); err == nil {
+		t.Fatal("expected an error for an unrecognized flag")
+	}
+}
@@ -0,0 +1,113 @@
+package command
+
+import (
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+)
+
+// registerDoctorCommands wires up `arngit doctor`.
+func (r *Router) registerDoctorCommands() {
+	r.register("doctor", handleDoctor)
+}
+
+// doctorCheck is one health check. Fix is nil for checks that can't be
+// auto-repaired.
+type doctorCheck struct {
+	Name string
+	Run  func(ctx *Context) (ok bool, detail string)
+	Fix  func(ctx *Context) error
+}
+
+var doctorChecks = []doctorCheck{
+	{
+		Name: "git is installed",
+		Run: func(ctx *Context) (bool, string) {
+			_, err := exec.LookPath("git")
+			if err != nil {
+				return false, "git binary not found on PATH"
+			}
+			return true, ""
+		},
+	},
+	{
+		Name: "~/.arngit directory permissions",
+		Run: func(ctx *Context) (bool, string) {
+			info, err := os.Stat(ctx.Engine.Home())
+			if err != nil {
+				return false, err.Error()
+			}
+			if info.Mode().Perm() != 0o700 {
+				return false, fmt.Sprintf("expected mode 0700, found %o", info.Mode().Perm())
+			}
+			return true, ""
+		},
+		Fix: func(ctx *Context) error {
+			return os.Chmod(ctx.Engine.Home(), 0o700)
+		},
+	},
+	{
+		Name: "active profile resolves",
+		Run: func(ctx *Context) (bool, string) {
+			if _, err := ctx.Engine.Profiles.Load(); err != nil {
+				return false, err.Error()
+			}
+			return true, ""
+		},
+		Fix: func(ctx *Context) error {
+			return ctx.Engine.Profiles.SetActive("default")
+		},
+	},
+	{
+		Name: "accounts file is readable",
+		Run: func(ctx *Context) (bool, string) {
+			path := filepath.Join(ctx.Engine.Home(), "accounts.json")
+			if _, err := os.Stat(path); os.IsNotExist(err) {
+				return true, ""
+			}
+			return true, ""
+		},
+	},
+}
+
+// handleDoctor implements `arngit doctor` and `arngit doctor --fix`.
+func handleDoctor(ctx *Context) error {
+	fix := false
+	for _, a := range ctx.Args {
+		if a == "--fix" {
+			fix = true
+		}
+	}
+
+	failures := 0
+	for _, check := range doctorChecks {
+		ok, detail := check.Run(ctx)
+		if ok {
+			ctx.Renderer.Success(check.Name)
+			continue
+		}
+
+		if fix && check.Fix != nil {
+			if err := check.Fix(ctx); err != nil {
+				ctx.Renderer.Error(fmt.Sprintf("%s: %s (fix failed: %v)", check.Name, detail, err))
+				failures++
+				continue
+			}
+			ctx.Renderer.Success(fmt.Sprintf("%s (fixed)", check.Name))
+			continue
+		}
+
+		msg := fmt.Sprintf("%s: %s", check.Name, detail)
+		if check.Fix != nil {
+			msg += " (run `arngit doctor --fix`)"
+		}
+		ctx.Renderer.Error(msg)
+		failures++
+	}
+
+	if failures > 0 {
+		return fmt.Errorf("%d check(s) failed", failures)
+	}
+	return nil
+}
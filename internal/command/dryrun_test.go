@@ -0,0 +1,57 @@
+package command
+
+import (
+	"os"
+	"os/exec"
+	"path/filepath"
+	"testing"
+
+	"github.com/buchorim/arngit/internal/core"
+)
+
+func TestDryRunPushDoesNotInvokeGit(t *testing.T) {
+	if _, err := exec.LookPath("git"); err != nil {
+		t.Skip("git not installed")
+	}
+
+	dir := t.TempDir()
+	run := func(args ...string) {
+		cmd := exec.Command("git", args...)
+		cmd.Dir = dir
+		if out, err := cmd.CombinedOutput(); err != nil {
+			t.Fatalf("git %v: %v: %s", args, err, out)
+		}
+	}
+	run("init", "-q")
+	run("config", "user.email", "test@example.com")
+	run("config", "user.name", "test")
+	if err := os.WriteFile(filepath.Join(dir, "f.txt"), []byte("hi"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	run("add", ".")
+	run("commit", "-q", "-m", "init")
+
+	chdir(t, dir)
+
+	router := NewRouter(&core.Engine{Config: core.DefaultConfig()}, "test")
+
+	out := captureStdout(t, func() {
+		if err := router.Dispatch([]string{"--dry-run", "push", "nonexistent-remote"}); err != nil {
+			t.Fatalf("Dispatch: %v", err)
+		}
+	})
+
+	if out == "" {
+		t.Fatal("expected dry-run to report the intended command")
+	}
+}
+
+func TestExtractGlobalFlagsDryRun(t *testing.T) {
+	flags, rest := extractGlobalFlags([]string{"--dry-run", "push"})
+	if !flags.dryRun {
+		t.Fatal("expected dryRun flag to be set")
+	}
+	if len(rest) != 1 || rest[0] != "push" {
+		t.Fatalf("rest = %v, want [push]", rest)
+	}
+}
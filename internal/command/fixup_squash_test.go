@@ -0,0 +1,93 @@
+package command
+
+import (
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/buchorim/arngit/internal/core"
+	"github.com/buchorim/arngit/internal/git"
+)
+
+func setupFixupTestRepo(t *testing.T) (string, string) {
+	t.Helper()
+	if _, err := exec.LookPath("git"); err != nil {
+		t.Skip("git not installed")
+	}
+
+	dir := t.TempDir()
+	run := func(args ...string) {
+		cmd := exec.Command("git", args...)
+		cmd.Dir = dir
+		if out, err := cmd.CombinedOutput(); err != nil {
+			t.Fatalf("git %v: %v: %s", args, err, out)
+		}
+	}
+	run("init", "-q")
+	run("config", "user.email", "test@example.com")
+	run("config", "user.name", "test")
+	if err := os.WriteFile(filepath.Join(dir, "f.txt"), []byte("one"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	run("add", ".")
+	run("commit", "-q", "-m", "base commit")
+
+	out, err := exec.Command("git", "-C", dir, "rev-parse", "HEAD").CombinedOutput()
+	if err != nil {
+		t.Fatalf("rev-parse HEAD: %v: %s", err, out)
+	}
+	return dir, strings.TrimSpace(string(out))
+}
+
+func TestCommitFixupBuildsCorrectArgs(t *testing.T) {
+	dir, base := setupFixupTestRepo(t)
+
+	if err := os.WriteFile(filepath.Join(dir, "f.txt"), []byte("two"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	chdir(t, dir)
+	router := NewRouter(&core.Engine{Config: core.DefaultConfig()}, "test")
+
+	if err := router.Dispatch([]string{"add", "."}); err != nil {
+		t.Fatalf("add: %v", err)
+	}
+	if err := router.Dispatch([]string{"commit", "--fixup", base}); err != nil {
+		t.Fatalf("commit --fixup: %v", err)
+	}
+
+	out, err := exec.Command("git", "-C", dir, "log", "-1", "--pretty=%s").CombinedOutput()
+	if err != nil {
+		t.Fatalf("log: %v: %s", err, out)
+	}
+	if got := strings.TrimSpace(string(out)); !strings.HasPrefix(got, "fixup!") {
+		t.Fatalf("expected a fixup! commit message, got %q", got)
+	}
+}
+
+func TestCommitFixupRejectsUnknownReference(t *testing.T) {
+	dir, _ := setupFixupTestRepo(t)
+
+	if err := os.WriteFile(filepath.Join(dir, "f.txt"), []byte("two"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	chdir(t, dir)
+	router := NewRouter(&core.Engine{Config: core.DefaultConfig()}, "test")
+
+	if err := router.Dispatch([]string{"add", "."}); err != nil {
+		t.Fatalf("add: %v", err)
+	}
+	if err := router.Dispatch([]string{"commit", "--fixup", "not-a-real-sha"}); err == nil {
+		t.Fatal("expected an error for an unresolvable --fixup reference")
+	}
+}
+
+func TestDescribeCommitFixupAndSquash(t *testing.T) {
+	if got, want := describeCommit("", git.CommitOptions{Fixup: "abc123"}), "git commit --fixup abc123"; got != want {
+		t.Errorf("describeCommit(fixup) = %q, want %q", got, want)
+	}
+	if got, want := describeCommit("", git.CommitOptions{Squash: "abc123"}), "git commit --squash abc123"; got != want {
+		t.Errorf("describeCommit(squash) = %q, want %q", got, want)
+	}
+}
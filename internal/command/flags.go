@@ -0,0 +1,115 @@
+package command
+
+import (
+	"fmt"
+	"strings"
+)
+
+// universalFlags are accepted on every command regardless of its declared
+// Flags, since Context.Confirm checks for them directly.
+var universalFlags = map[string]bool{"yes": true, "y": true}
+
+// matches reports whether flag (given without its leading dashes, and
+// without any "=value" suffix) refers to spec by long or short name.
+func (spec FlagSpec) matches(flag string) bool {
+	return flag == spec.Name || (spec.Shorthand != "" && flag == spec.Shorthand)
+}
+
+// validateFlags rejects any "-"-prefixed argument in args that isn't one of
+// cmd's declared Flags (or a universal flag). Commands that haven't
+// declared any Flags are left unvalidated, since their handlers still parse
+// ctx.Args by hand.
+func validateFlags(cmd Command, args []string) error {
+	if len(cmd.Flags) == 0 {
+		return nil
+	}
+
+	for _, a := range args {
+		if !strings.HasPrefix(a, "-") {
+			continue
+		}
+
+		name := strings.TrimLeft(a, "-")
+		if idx := strings.Index(name, "="); idx >= 0 {
+			name = name[:idx]
+		}
+		if universalFlags[name] {
+			continue
+		}
+
+		known := false
+		for _, spec := range cmd.Flags {
+			if spec.matches(name) {
+				known = true
+				break
+			}
+		}
+		if known {
+			continue
+		}
+
+		if suggestion := suggestFlag(cmd.Flags, name); suggestion != "" {
+			return fmt.Errorf("unknown flag: %s (did you mean --%s?)", a, suggestion)
+		}
+		return fmt.Errorf("unknown flag: %s", a)
+	}
+
+	return nil
+}
+
+// ParsedFlags is the result of parseFlags: which boolean flags were set,
+// what value flags were assigned (both keyed by FlagSpec.Name, not
+// shorthand), and the remaining positional arguments, in the order they
+// appeared.
+type ParsedFlags struct {
+	Bool       map[string]bool
+	Value      map[string]string
+	Positional []string
+}
+
+// parseFlags walks args against specs, splitting them into recognized
+// flags and the remaining positional arguments. It assumes args has
+// already passed validateFlags, so an argument that starts with "-" but
+// matches no spec is dropped rather than erroring.
+func parseFlags(specs []FlagSpec, args []string) ParsedFlags {
+	parsed := ParsedFlags{Bool: map[string]bool{}, Value: map[string]string{}}
+
+	for _, a := range args {
+		if !strings.HasPrefix(a, "-") {
+			parsed.Positional = append(parsed.Positional, a)
+			continue
+		}
+
+		name := strings.TrimLeft(a, "-")
+		value := ""
+		if idx := strings.Index(name, "="); idx >= 0 {
+			name, value = name[:idx], name[idx+1:]
+		}
+
+		for _, spec := range specs {
+			if !spec.matches(name) {
+				continue
+			}
+			if spec.TakesValue {
+				parsed.Value[spec.Name] = value
+			} else {
+				parsed.Bool[spec.Name] = true
+			}
+			break
+		}
+	}
+
+	return parsed
+}
+
+// suggestFlag returns the declared flag name closest to name by edit
+// distance, or "" if nothing is close enough to be worth suggesting.
+func suggestFlag(flags []FlagSpec, name string) string {
+	best, bestDistance := "", suggestThreshold+1
+	for _, spec := range flags {
+		if d := levenshtein(name, spec.Name); d < bestDistance {
+			best, bestDistance = spec.Name, d
+		}
+	}
+	return best
+}
@@ -0,0 +1,37 @@
+package command
+
+import "testing"
+
+func TestParseFlagsMixesPositionalAndFlags(t *testing.T) {
+	flags := parseFlags(pushFlags, []string{"--force", "origin", "feature", "--set-upstream"})
+
+	if !flags.Bool["force"] || !flags.Bool["set-upstream"] {
+		t.Fatalf("Bool = %v, want force and set-upstream both set", flags.Bool)
+	}
+	if len(flags.Positional) != 2 || flags.Positional[0] != "origin" || flags.Positional[1] != "feature" {
+		t.Fatalf("Positional = %v, want [origin feature]", flags.Positional)
+	}
+}
+
+// TestParseFlagsPushOriginFeature guards against the branch-index bug where
+// a naive range over ctx.Args could capture the wrong token as the branch
+// once flags were interleaved with positional args.
+func TestParseFlagsPushOriginFeature(t *testing.T) {
+	flags := parseFlags(pushFlags, []string{"origin", "feature"})
+
+	if len(flags.Positional) != 2 {
+		t.Fatalf("Positional = %v, want 2 entries", flags.Positional)
+	}
+	remote, branch := flags.Positional[0], flags.Positional[1]
+	if remote != "origin" || branch != "feature" {
+		t.Errorf("remote, branch = %q, %q, want origin, feature", remote, branch)
+	}
+}
+
+func TestParseFlagsPreservesPositionalOrderRegardlessOfFlagPlacement(t *testing.T) {
+	flags := parseFlags(pushFlags, []string{"origin", "--force", "feature"})
+
+	if len(flags.Positional) != 2 || flags.Positional[0] != "origin" || flags.Positional[1] != "feature" {
+		t.Fatalf("Positional = %v, want [origin feature]", flags.Positional)
+	}
+}
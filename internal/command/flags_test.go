@@ -0,0 +1,34 @@
+package command
+
+import (
+	"testing"
+
+	"github.com/buchorim/arngit/internal/core"
+)
+
+func TestDispatchRejectsUnknownFlag(t *testing.T) {
+	dir := t.TempDir()
+	chdir(t, dir)
+	// Not a repo, but push's RequiresRepo check happens after flag
+	// validation, so an unknown flag should be caught first.
+	router := NewRouter(&core.Engine{Config: core.DefaultConfig()}, "test")
+
+	err := router.Dispatch([]string{"push", "--forcce"})
+	if err == nil {
+		t.Fatal("expected an error for an unrecognized flag")
+	}
+}
+
+func TestDispatchAcceptsKnownFlag(t *testing.T) {
+	if err := validateFlags(Command{
+		Flags: []FlagSpec{{Name: "force", Shorthand: "f"}},
+	}, []string{"--force"}); err != nil {
+		t.Errorf("validateFlags() = %v, want nil for a known flag", err)
+	}
+}
+
+func TestValidateFlagsAllowsUndeclaredCommands(t *testing.T) {
+	if err := validateFlags(Command{}, []string{"--whatever"}); err != nil {
+		t.Errorf("validateFlags() = %v, want nil for a command with no declared flags", err)
+	}
+}
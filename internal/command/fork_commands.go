@@ -0,0 +1,85 @@
+package command
+
+import (
+	"fmt"
+	"net/url"
+	"time"
+
+	"github.com/buchorim/arngit/internal/git"
+	"github.com/buchorim/arngit/internal/github"
+)
+
+// forkFlags declares the flags `arngit fork` accepts.
+var forkFlags = []FlagSpec{
+	{Name: "org", Description: "Fork into this organization instead of your account", TakesValue: true},
+	{Name: "clone", Description: "Clone the fork into ./<repo> and add an 'upstream' remote for the original"},
+}
+
+// registerForkCommands wires up `arngit fork`.
+func (r *Router) registerForkCommands() {
+	r.registerCommand(Command{
+		Name:            "fork",
+		Handler:         handleFork,
+		RequiresAccount: true,
+		Flags:           forkFlags,
+	})
+}
+
+// handleFork implements `arngit fork <owner/repo> [--org X] [--clone]`,
+// streamlining the contributor workflow of forking a repo and getting a
+// local clone with "upstream" already pointed at the original.
+func handleFork(ctx *Context) error {
+	flags := parseFlags(forkFlags, ctx.Args)
+	if len(flags.Positional) != 1 {
+		return fmt.Errorf("usage: arngit fork <owner/repo> [--org X] [--clone]")
+	}
+	owner, repo, err := splitOwnerRepo(flags.Positional[0])
+	if err != nil {
+		return err
+	}
+
+	client, err := ctx.Engine.GitHubClient()
+	if err != nil {
+		return err
+	}
+
+	if ctx.DryRun {
+		ctx.Renderer.Info(fmt.Sprintf("dry run: would fork %s/%s", owner, repo))
+		return nil
+	}
+
+	fork, err := client.ForkRepo(owner, repo, flags.Value["org"])
+	if err != nil {
+		return wrapGitHubError(err)
+	}
+	ctx.Renderer.Success(fmt.Sprintf("forked to %s: %s", fork.FullName, fork.HTMLURL))
+
+	if !flags.Bool["clone"] {
+		return nil
+	}
+	return cloneForkWithUpstream(ctx, fork, owner, repo)
+}
+
+// cloneForkWithUpstream clones fork into ./<name> and adds an "upstream"
+// remote pointing back at owner/repo, the repo it was forked from.
+func cloneForkWithUpstream(ctx *Context, fork *github.Repo, owner, repo string) error {
+	networkTimeout, _ := time.ParseDuration(ctx.Engine.Config.NetworkTimeout)
+	cloneOpts := git.CloneOptions{Timeout: networkTimeout, ProxyURL: ctx.Engine.Config.HTTPProxy}
+
+	if _, err := git.Clone(fork.CloneURL, fork.Name, cloneOpts); err != nil {
+		return fmt.Errorf("cloning fork: %w", err)
+	}
+
+	upstreamURL := fork.CloneURL
+	if parsed, err := url.Parse(fork.CloneURL); err == nil {
+		upstreamURL = fmt.Sprintf("%s://%s/%s/%s.git", parsed.Scheme, parsed.Host, owner, repo)
+	}
+
+	cloneDir := git.NewService(fork.Name)
+	if _, err := cloneDir.AddRemote("upstream", upstreamURL); err != nil {
+		return fmt.Errorf("adding upstream remote: %w", err)
+	}
+
+	ctx.Renderer.Success(fmt.Sprintf("cloned into ./%s with upstream %s", fork.Name, upstreamURL))
+	return nil
+}
@@ -0,0 +1,64 @@
+package command
+
+import (
+	"errors"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"testing"
+
+	"github.com/buchorim/arngit/internal/core"
+	"github.com/buchorim/arngit/internal/github"
+	"github.com/buchorim/arngit/internal/ui"
+)
+
+func TestCloneForkWithUpstreamAddsUpstreamRemote(t *testing.T) {
+	if _, err := exec.LookPath("git"); err != nil {
+		t.Skip("git not installed")
+	}
+	dir := t.TempDir()
+	chdir(t, dir)
+
+	origin := filepath.Join(dir, "origin.git")
+	if out, err := exec.Command("git", "init", "-q", "--bare", origin).CombinedOutput(); err != nil {
+		t.Fatalf("git init --bare: %v: %s", err, out)
+	}
+
+	engine := &core.Engine{Config: core.DefaultConfig(), Accounts: &core.AccountStore{Active: "work"}}
+	ctx := &Context{Engine: engine, Renderer: ui.NewRenderer(false, "")}
+
+	fork := &github.Repo{Name: "widgets", FullName: "myorg/widgets", CloneURL: origin}
+	if err := cloneForkWithUpstream(ctx, fork, "acme", "widgets"); err != nil {
+		t.Fatalf("cloneForkWithUpstream: %v", err)
+	}
+
+	out, err := exec.Command("git", "-C", filepath.Join(dir, "widgets"), "remote", "get-url", "upstream").CombinedOutput()
+	if err != nil {
+		t.Fatalf("remote get-url upstream: %v: %s", err, out)
+	}
+}
+
+func TestForkRequiresAccountDeclinesWithoutAccount(t *testing.T) {
+	readEnd, writeEnd, err := os.Pipe()
+	if err != nil {
+		t.Fatalf("Pipe: %v", err)
+	}
+	writeEnd.Close()
+	originalStdin := os.Stdin
+	os.Stdin = readEnd
+	defer func() { os.Stdin = originalStdin }()
+
+	router := NewRouter(&core.Engine{Config: core.DefaultConfig(), Accounts: &core.AccountStore{}}, "test")
+	err = router.Dispatch([]string{"fork", "acme/widgets"})
+	if !errors.Is(err, core.ErrNoAccount) {
+		t.Errorf("err = %v, want ErrNoAccount", err)
+	}
+}
+
+func TestForkRejectsMalformedArgument(t *testing.T) {
+	router := NewRouter(&core.Engine{Config: core.DefaultConfig(), Accounts: &core.AccountStore{Active: "work"}}, "test")
+	err := router.Dispatch([]string{"fork", "not-owner-slash-repo"})
+	if err == nil {
+		t.Fatal("expected an error for a malformed owner/repo argument")
+	}
+}
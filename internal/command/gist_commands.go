@@ -0,0 +1,133 @@
+package command
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+
+	"github.com/buchorim/arngit/internal/github"
+)
+
+// gistFlags declares the flags `arngit gist create` accepts.
+var gistFlags = []FlagSpec{
+	{Name: "description", Shorthand: "d", Description: "Gist description", TakesValue: true},
+	{Name: "public", Description: "Make the gist public (default: secret)"},
+}
+
+// registerGistCommands wires up `arngit gist`.
+func (r *Router) registerGistCommands() {
+	r.registerCommand(Command{
+		Name:            "gist",
+		Handler:         handleGist,
+		RequiresAccount: true,
+		Subcommands:     []string{"create", "list", "remove"},
+		Flags:           gistFlags,
+	})
+}
+
+// handleGist dispatches `arngit gist <create|list|remove>`.
+func handleGist(ctx *Context) error {
+	if len(ctx.Args) == 0 {
+		return fmt.Errorf("usage: arngit gist <create <file>...|list|remove <id>>")
+	}
+	sub, rest := ctx.Args[0], ctx.Args[1:]
+
+	client, err := ctx.Engine.GitHubClient()
+	if err != nil {
+		return err
+	}
+
+	switch sub {
+	case "create":
+		return gistCreate(ctx, client, rest)
+	case "list":
+		return gistList(ctx, client)
+	case "remove":
+		return gistRemove(ctx, client, rest)
+	default:
+		return fmt.Errorf("unknown gist subcommand: %s", sub)
+	}
+}
+
+// readGistFile reads path's contents, or stdin's when path is "-", and
+// returns the name the content should be uploaded under.
+func readGistFile(path string) (name, content string, err error) {
+	if path == "-" {
+		data, err := io.ReadAll(os.Stdin)
+		if err != nil {
+			return "", "", fmt.Errorf("reading gist content from stdin: %w", err)
+		}
+		return "stdin", string(data), nil
+	}
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return "", "", fmt.Errorf("reading %s: %w", path, err)
+	}
+	return filepath.Base(path), string(data), nil
+}
+
+func gistCreate(ctx *Context, client *github.Client, args []string) error {
+	flags := parseFlags(gistFlags, args)
+	if len(flags.Positional) == 0 {
+		return fmt.Errorf("usage: arngit gist create <file>... [--description=...] [--public]")
+	}
+
+	files := make(map[string]string, len(flags.Positional))
+	for _, path := range flags.Positional {
+		name, content, err := readGistFile(path)
+		if err != nil {
+			return err
+		}
+		files[name] = content
+	}
+
+	if ctx.DryRun {
+		ctx.Renderer.Info(fmt.Sprintf("dry run: would create a gist with %d file(s)", len(files)))
+		return nil
+	}
+
+	gist, err := client.CreateGist(files, flags.Bool["public"], flags.Value["description"])
+	if err != nil {
+		return wrapGitHubError(err)
+	}
+	ctx.Renderer.Success(fmt.Sprintf("created gist %s: %s", gist.ID, gist.HTMLURL))
+	return nil
+}
+
+func gistList(ctx *Context, client *github.Client) error {
+	gists, err := client.ListGists()
+	if err != nil {
+		return wrapGitHubError(err)
+	}
+	if len(gists) == 0 {
+		ctx.Renderer.Plain("no gists found")
+		return nil
+	}
+	for _, g := range gists {
+		desc := g.Description
+		if desc == "" {
+			desc = "(no description)"
+		}
+		ctx.Renderer.Plain(fmt.Sprintf("%s  %s  public=%t  %s", g.ID, desc, g.Public, g.HTMLURL))
+	}
+	return nil
+}
+
+func gistRemove(ctx *Context, client *github.Client, args []string) error {
+	if len(args) != 1 {
+		return fmt.Errorf("usage: arngit gist remove <id>")
+	}
+	id := args[0]
+
+	if ctx.DryRun {
+		ctx.Renderer.Info(fmt.Sprintf("dry run: would remove gist %s", id))
+		return nil
+	}
+	if err := client.DeleteGist(id); err != nil {
+		return wrapGitHubError(err)
+	}
+	ctx.Renderer.Success(fmt.Sprintf("removed gist %s", id))
+	return nil
+}
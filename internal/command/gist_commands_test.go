@@ -0,0 +1,66 @@
+package command
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/buchorim/arngit/internal/core"
+)
+
+func TestReadGistFileFromPath(t *testing.T) {
+	dir := t.TempDir()
+	path := filepath.Join(dir, "notes.txt")
+	if err := os.WriteFile(path, []byte("hello\n"), 0o644); err != nil {
+		t.Fatalf("WriteFile: %v", err)
+	}
+
+	name, content, err := readGistFile(path)
+	if err != nil {
+		t.Fatalf("readGistFile: %v", err)
+	}
+	if name != "notes.txt" || content != "hello\n" {
+		t.Errorf("name = %q, content = %q", name, content)
+	}
+}
+
+func TestReadGistFileFromStdin(t *testing.T) {
+	readEnd, writeEnd, err := os.Pipe()
+	if err != nil {
+		t.Fatalf("Pipe: %v", err)
+	}
+	originalStdin := os.Stdin
+	os.Stdin = readEnd
+	defer func() { os.Stdin = originalStdin }()
+
+	go func() {
+		writeEnd.Write([]byte("piped content"))
+		writeEnd.Close()
+	}()
+
+	name, content, err := readGistFile("-")
+	if err != nil {
+		t.Fatalf("readGistFile: %v", err)
+	}
+	if name != "stdin" || content != "piped content" {
+		t.Errorf("name = %q, content = %q", name, content)
+	}
+}
+
+func TestGistRequiresAccountDeclinesWithoutAccount(t *testing.T) {
+	readEnd, writeEnd, err := os.Pipe()
+	if err != nil {
+		t.Fatalf("Pipe: %v", err)
+	}
+	writeEnd.Close()
+	originalStdin := os.Stdin
+	os.Stdin = readEnd
+	defer func() { os.Stdin = originalStdin }()
+
+	router := NewRouter(&core.Engine{Config: core.DefaultConfig(), Accounts: &core.AccountStore{}}, "test")
+	err = router.Dispatch([]string{"gist", "list"})
+	if !errors.Is(err, core.ErrNoAccount) {
+		t.Errorf("err = %v, want ErrNoAccount", err)
+	}
+}
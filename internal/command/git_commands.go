@@ -0,0 +1,511 @@
+package command
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	"github.com/buchorim/arngit/internal/git"
+)
+
+// pushFlags declares the flags handlePush accepts, shared with Command.Flags
+// (for help/validation) and parseFlags (for parsing).
+var pushFlags = []FlagSpec{
+	{Name: "force", Shorthand: "f", Description: "Force the push, overwriting the remote branch"},
+	{Name: "set-upstream", Shorthand: "u", Description: "Set the upstream branch for future pushes"},
+	{Name: "no-verify", Description: "Skip the pre-push hook"},
+	{Name: "all", Description: "Push all local branches"},
+	{Name: "mirror", Description: "Push all refs, deleting remote refs that no longer exist locally"},
+}
+
+// pullFlags declares the flags handlePull accepts.
+var pullFlags = []FlagSpec{
+	{Name: "rebase", Description: "Rebase local commits on top of the pulled branch instead of merging"},
+	{Name: "ff-only", Description: "Fail instead of merging or rebasing if the branches have diverged"},
+}
+
+// diffFlags declares the flags handleDiff accepts.
+var diffFlags = []FlagSpec{
+	{Name: "stat", Description: "Show a diffstat instead of the full diff"},
+	{Name: "name-only", Description: "Show only the names of changed files"},
+	{Name: "staged", Description: "Diff the index against HEAD instead of the working tree"},
+}
+
+// registerGitCommands wires up the standard git-passthrough commands.
+func (r *Router) registerGitCommands() {
+	r.registerCommand(Command{Name: "status", Handler: handleStatus, RequiresRepo: true, Flags: statusFlags})
+	r.registerRepoCommand("add", handleAdd)
+	r.registerRepoCommand("commit", handleCommit)
+	r.registerCommand(Command{Name: "push", Handler: handlePush, RequiresRepo: true, Flags: pushFlags})
+	r.registerCommand(Command{Name: "pull", Handler: handlePull, RequiresRepo: true, Flags: pullFlags})
+	r.registerRepoCommand("fetch", handleFetch)
+	r.registerCommand(Command{Name: "diff", Handler: handleDiff, RequiresRepo: true, Flags: diffFlags})
+	r.registerRepoCommand("history", handleHistory)
+	r.registerRepoCommand("file-log", handleFileLog)
+}
+
+// statusFlags declares the flags handleStatus accepts.
+var statusFlags = []FlagSpec{
+	{Name: "short", Description: "Show git status -s style two-column output"},
+	{Name: "porcelain", Description: "Show the raw machine-readable status format, for scripts"},
+}
+
+// handleStatus implements `arngit status`: a verbose, sectioned view by
+// default, or the raw two-column format with --short/--porcelain (kept as
+// two flags, matching git itself, even though they currently emit the same
+// bytes) for scripts that want a stable machine format.
+func handleStatus(ctx *Context) error {
+	flags := parseFlags(statusFlags, ctx.Args)
+	if flags.Bool["short"] && flags.Bool["porcelain"] {
+		return fmt.Errorf("--short and --porcelain cannot be used together")
+	}
+
+	raw, err := ctx.Git.Status()
+	if err != nil {
+		return err
+	}
+	if raw == "" {
+		ctx.Renderer.Success("working tree clean")
+		return nil
+	}
+
+	if flags.Bool["short"] || flags.Bool["porcelain"] {
+		ctx.Renderer.Plain(raw)
+		return nil
+	}
+
+	return renderVerboseStatus(ctx, git.ParseStatus(raw))
+}
+
+// renderVerboseStatus prints status grouped into Staged/Modified/Untracked/
+// Conflicted sections, the way plain `git status` groups its own output.
+func renderVerboseStatus(ctx *Context, status git.RepoStatus) error {
+	if branch, err := ctx.Git.CurrentBranch(); err == nil {
+		ctx.Renderer.Plain(fmt.Sprintf("On branch %s", branch))
+	}
+
+	printSection := func(title string, entries []git.StatusEntry) {
+		if len(entries) == 0 {
+			return
+		}
+		ctx.Renderer.Plain(title + ":")
+		for _, e := range entries {
+			ctx.Renderer.Plain("  " + e.Path)
+		}
+	}
+	printSection("Conflicted", status.Conflicted())
+	printSection("Staged", status.Staged())
+	printSection("Modified", status.Modified())
+	printSection("Untracked", status.Untracked())
+	return nil
+}
+
+func handleAdd(ctx *Context) error {
+	paths := ctx.Args
+	if len(paths) == 0 {
+		paths = []string{"."}
+	}
+	if err := ctx.Git.Add(paths...); err != nil {
+		return err
+	}
+	recordJournal(ctx, "add", paths, "")
+	ctx.Renderer.Success("staged changes")
+	return nil
+}
+
+func handleCommit(ctx *Context) error {
+	message, fixup, squash, messageFile := "", "", "", ""
+	noVerify := hasFlag(ctx.Args, "--no-verify")
+	for i, a := range ctx.Args {
+		switch {
+		case (a == "-m" || a == "--message") && i+1 < len(ctx.Args):
+			message = ctx.Args[i+1]
+		case (a == "-F" || a == "--message-file") && i+1 < len(ctx.Args):
+			messageFile = ctx.Args[i+1]
+		case a == "--fixup" && i+1 < len(ctx.Args):
+			fixup = ctx.Args[i+1]
+		case a == "--squash" && i+1 < len(ctx.Args):
+			squash = ctx.Args[i+1]
+		}
+	}
+
+	if messageFile != "" {
+		read, err := readCommitMessageFile(messageFile)
+		if err != nil {
+			return err
+		}
+		message = read
+	}
+
+	all := hasFlag(ctx.Args, "-a") || hasFlag(ctx.Args, "--all")
+	opts := git.CommitOptions{Fixup: fixup, Squash: squash, NoVerify: noVerify, All: all}
+
+	target := fixup
+	if target == "" {
+		target = squash
+	}
+	if target != "" {
+		if _, err := ctx.Git.ResolveRef(target); err != nil {
+			return fmt.Errorf("commit reference %q not found", target)
+		}
+	} else if strings.TrimSpace(message) == "" {
+		return errors.New("commit message required: use -m \"message\", -F <file>, or --message-file -")
+	}
+
+	if ctx.Engine.Config.AutoStage {
+		if err := ctx.Git.Add("."); err != nil {
+			return err
+		}
+	}
+
+	if maxSize := ctx.Engine.Config.MaxFileSize; maxSize > 0 {
+		staged, err := ctx.Git.StagedFiles()
+		if err != nil {
+			return err
+		}
+		var large []git.FileStatus
+		for _, f := range staged {
+			if f.Size > maxSize {
+				large = append(large, f)
+			}
+		}
+		for _, f := range large {
+			ctx.Renderer.Warn(fmt.Sprintf("%s is %d bytes, over the %d byte limit", f.Path, f.Size, maxSize))
+		}
+		if len(large) > 0 && !ctx.Confirm("commit these large file(s) anyway?") {
+			return errors.New("commit aborted: large staged file(s)")
+		}
+	}
+
+	if ctx.Engine.Config.ScanSecrets {
+		diff, err := ctx.Git.Diff(git.DiffOptions{Staged: true})
+		if err != nil {
+			return err
+		}
+		findings := scanSecrets(diff)
+		for _, f := range findings {
+			ctx.Renderer.Warn(f)
+		}
+		if len(findings) > 0 && !ctx.Confirm("commit anyway despite possible secrets?") {
+			return errors.New("commit aborted: possible secrets in the staged diff")
+		}
+	}
+
+	if noVerify {
+		ctx.Renderer.Warn("skipping commit hooks (--no-verify)")
+	}
+
+	if ctx.DryRun {
+		ctx.Renderer.Info(fmt.Sprintf("dry run: would run %s", describeCommit(message, opts)))
+		return nil
+	}
+
+	out, err := ctx.Git.Commit(message, opts)
+	if err != nil {
+		return err
+	}
+	if head, headErr := ctx.Git.ResolveRef("HEAD"); headErr == nil {
+		recordJournal(ctx, "commit", nil, head)
+	}
+	ctx.Renderer.Success("committed")
+	ctx.Renderer.Plain(out)
+
+	if ctx.Engine.Config.PushAfterCommit {
+		pushOut, err := ctx.Git.Push("", "", git.PushOptions{})
+		if err != nil {
+			return err
+		}
+		ctx.Renderer.Success("pushed")
+		if pushOut != "" {
+			ctx.Renderer.Plain(pushOut)
+		}
+	}
+	return nil
+}
+
+// readCommitMessageFile reads a commit message from path, or from stdin
+// when path is "-" (git's own convention for "-F -").
+func readCommitMessageFile(path string) (string, error) {
+	if path == "-" {
+		data, err := io.ReadAll(os.Stdin)
+		if err != nil {
+			return "", fmt.Errorf("reading commit message from stdin: %w", err)
+		}
+		return strings.TrimRight(string(data), "\n"), nil
+	}
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return "", fmt.Errorf("reading commit message file %q: %w", path, err)
+	}
+	return strings.TrimRight(string(data), "\n"), nil
+}
+
+// describeCommit renders the git invocation Commit(message, opts) would
+// make, for --dry-run reporting.
+func describeCommit(message string, opts git.CommitOptions) string {
+	flags := ""
+	if opts.NoVerify {
+		flags += "--no-verify "
+	}
+	if opts.All {
+		flags += "-a "
+	}
+	switch {
+	case opts.Fixup != "":
+		return fmt.Sprintf("git commit %s--fixup %s", flags, opts.Fixup)
+	case opts.Squash != "":
+		return fmt.Sprintf("git commit %s--squash %s", flags, opts.Squash)
+	default:
+		return fmt.Sprintf("git commit %s-m %q", flags, message)
+	}
+}
+
+// handlePush implements `arngit push [remote] [branch] [--force] [--set-upstream]`.
+// remote and branch are taken from the first two positional arguments, in
+// that order, however the flags are interleaved with them.
+func handlePush(ctx *Context) error {
+	flags := parseFlags(pushFlags, ctx.Args)
+
+	remote, branch := "", ""
+	if len(flags.Positional) > 0 {
+		remote = flags.Positional[0]
+	}
+	if len(flags.Positional) > 1 {
+		branch = flags.Positional[1]
+	}
+
+	opts := git.PushOptions{
+		Force:       flags.Bool["force"],
+		SetUpstream: flags.Bool["set-upstream"],
+		NoVerify:    flags.Bool["no-verify"],
+		All:         flags.Bool["all"],
+		Mirror:      flags.Bool["mirror"],
+	}
+
+	if opts.All && opts.Mirror {
+		return fmt.Errorf("--all and --mirror cannot be used together")
+	}
+
+	if opts.NoVerify {
+		ctx.Renderer.Warn("skipping the pre-push hook (--no-verify)")
+	}
+
+	if opts.Mirror && !ctx.Confirm("--mirror can delete refs on the remote that don't exist locally. Continue?") {
+		return errors.New("push aborted")
+	}
+
+	if ctx.DryRun {
+		ctx.Renderer.Info(fmt.Sprintf("dry run: would run %s", describePush(remote, branch, opts)))
+		return nil
+	}
+
+	out, err := ctx.Git.Push(remote, branch, opts)
+	if err != nil {
+		return err
+	}
+	head, _ := ctx.Git.ResolveRef("HEAD")
+	recordJournal(ctx, "push", flags.Positional, head)
+	ctx.Renderer.Success("pushed")
+	if out != "" {
+		ctx.Renderer.Plain(out)
+	}
+	return nil
+}
+
+func handlePull(ctx *Context) error {
+	flags := parseFlags(pullFlags, ctx.Args)
+
+	if flags.Bool["rebase"] && flags.Bool["ff-only"] {
+		return fmt.Errorf("--rebase and --ff-only cannot be used together")
+	}
+
+	opts, err := pullOptionsFor(flags, ctx.Engine.Config.PullStrategy)
+	if err != nil {
+		return err
+	}
+
+	if ctx.DryRun {
+		ctx.Renderer.Info(fmt.Sprintf("dry run: would run %s", describePull(opts)))
+		return nil
+	}
+
+	// oldHead is best-effort: a fresh repo with an unborn HEAD has none yet,
+	// and that's fine, pullSummary treats "" as "nothing to compare from".
+	oldHead, _ := ctx.Git.ResolveRef("HEAD")
+
+	out, err := ctx.Git.Pull(opts)
+	if err != nil {
+		return err
+	}
+
+	newHead, err := ctx.Git.ResolveRef("HEAD")
+	if err != nil {
+		ctx.Renderer.Success("pulled")
+		return nil
+	}
+
+	ctx.Renderer.Success(pullSummary(ctx.Git, oldHead, newHead))
+	if out != "" {
+		ctx.Renderer.Plain(out)
+	}
+	return nil
+}
+
+// pullSummary reports what Pull actually changed: "already up to date" when
+// HEAD didn't move, or the number of commits pulled plus a files-changed
+// diffstat otherwise.
+func pullSummary(gitSvc *git.Service, oldHead, newHead string) string {
+	if oldHead == "" || oldHead == newHead {
+		return "already up to date"
+	}
+
+	n, err := gitSvc.RevListCount(oldHead + ".." + newHead)
+	if err != nil {
+		return "pulled"
+	}
+
+	commitWord := "commit"
+	if n != 1 {
+		commitWord = "commits"
+	}
+	summary := fmt.Sprintf("pulled %d %s", n, commitWord)
+
+	stat, err := gitSvc.Diff(git.DiffOptions{Ref1: oldHead, Ref2: newHead, Stat: true})
+	if err == nil && strings.TrimSpace(stat) != "" {
+		summary += "\n" + strings.TrimSpace(stat)
+	}
+	return summary
+}
+
+// pullOptionsFor resolves the effective PullOptions for a `pull` invocation:
+// an explicit flag always wins, otherwise the configured pull_strategy
+// applies, otherwise it's a plain pull (git's own merge default).
+func pullOptionsFor(flags ParsedFlags, strategy string) (git.PullOptions, error) {
+	if flags.Bool["rebase"] {
+		return git.PullOptions{Rebase: true}, nil
+	}
+	if flags.Bool["ff-only"] {
+		return git.PullOptions{FFOnly: true}, nil
+	}
+
+	switch strategy {
+	case "", "merge":
+		return git.PullOptions{}, nil
+	case "rebase":
+		return git.PullOptions{Rebase: true}, nil
+	case "ff-only":
+		return git.PullOptions{FFOnly: true}, nil
+	default:
+		return git.PullOptions{}, fmt.Errorf("unknown pull_strategy: %s", strategy)
+	}
+}
+
+// describePull renders the git invocation Pull(opts) would make, for
+// --dry-run reporting.
+func describePull(opts git.PullOptions) string {
+	switch {
+	case opts.Rebase:
+		return "git pull --rebase"
+	case opts.FFOnly:
+		return "git pull --ff-only"
+	default:
+		return "git pull"
+	}
+}
+
+// handleFetch implements `arngit fetch`.
+func handleFetch(ctx *Context) error {
+	if ctx.DryRun {
+		ctx.Renderer.Info("dry run: would run git fetch")
+		return nil
+	}
+
+	out, err := ctx.Git.Fetch()
+	if err != nil {
+		return err
+	}
+	ctx.Renderer.Success("fetched")
+	if out != "" {
+		ctx.Renderer.Plain(out)
+	}
+	return nil
+}
+
+// describePush renders the git invocation Push(remote, branch, opts) would
+// make, for --dry-run reporting.
+func describePush(remote, branch string, opts git.PushOptions) string {
+	args := []string{"git", "push"}
+	if opts.Force {
+		args = append(args, "--force")
+	}
+	if opts.SetUpstream {
+		args = append(args, "--set-upstream")
+	}
+	if opts.NoVerify {
+		args = append(args, "--no-verify")
+	}
+	if opts.Mirror {
+		args = append(args, "--mirror")
+	} else if opts.All {
+		args = append(args, "--all")
+	}
+	if remote != "" {
+		args = append(args, remote)
+	}
+	if branch != "" && !opts.All && !opts.Mirror {
+		args = append(args, branch)
+	}
+	return strings.Join(args, " ")
+}
+
+// handleDiff implements `arngit diff`, `arngit diff main..feature`,
+// `arngit diff main feature`, `arngit diff --stat`, and `arngit diff
+// --name-only`.
+func handleDiff(ctx *Context) error {
+	flags := parseFlags(diffFlags, ctx.Args)
+
+	opts := git.DiffOptions{
+		Stat:     flags.Bool["stat"],
+		NameOnly: flags.Bool["name-only"],
+		Staged:   flags.Bool["staged"],
+	}
+	opts.Ref1, opts.Ref2 = git.ParseDiffRefs(flags.Positional)
+
+	out, err := ctx.Git.Diff(opts)
+	if err != nil {
+		return err
+	}
+	ctx.Renderer.Plain(out)
+	return nil
+}
+
+func handleHistory(ctx *Context) error {
+	out, err := ctx.Git.History(20)
+	if err != nil {
+		return err
+	}
+	ctx.Renderer.Plain(out)
+	return nil
+}
+
+// handleFileLog implements `arngit file-log <path>`, showing a file's
+// commit history (following renames), like `history` but scoped to one path.
+func handleFileLog(ctx *Context) error {
+	if len(ctx.Args) == 0 {
+		return errors.New("usage: arngit file-log <path>")
+	}
+	path := ctx.Args[0]
+
+	commits, err := ctx.Git.FileLog(path, 20)
+	if err != nil {
+		return err
+	}
+
+	for _, c := range commits {
+		ctx.Renderer.Plain(shortHash(c.Hash) + " " + c.Message)
+	}
+	return nil
+}
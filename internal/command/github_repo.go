@@ -0,0 +1,32 @@
+package command
+
+import (
+	"errors"
+
+	"github.com/buchorim/arngit/internal/core"
+	"github.com/buchorim/arngit/internal/git"
+	"github.com/buchorim/arngit/internal/github"
+)
+
+// currentOwnerRepo resolves the owner and repo name for the current
+// directory's "origin" remote, for commands that operate on "this repo" on
+// GitHub rather than one named explicitly on the command line.
+func currentOwnerRepo(ctx *Context) (owner, repo string, err error) {
+	remote, err := ctx.Git.RemoteURL("origin")
+	if err != nil {
+		return "", "", err
+	}
+	_, owner, repo, err = git.ParseRemoteURL(remote)
+	return owner, repo, err
+}
+
+// wrapGitHubError maps a raw API error onto arngit's typed error registry
+// when possible, so GitHub command handlers report the same kind of hinted
+// errors as the rest of arngit.
+func wrapGitHubError(err error) error {
+	var apiErr *github.APIError
+	if errors.As(err, &apiErr) {
+		return core.FromAPIError(apiErr)
+	}
+	return err
+}
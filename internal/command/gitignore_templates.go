@@ -0,0 +1,31 @@
+package command
+
+// gitignoreTemplates bundles a handful of common .gitignore templates for
+// offline use by `ignore add`/`ignore list`, so the common case doesn't
+// need network access. Not exhaustive by design — see github/gitignore for
+// the full set.
+var gitignoreTemplates = map[string]string{
+	"go": `*.exe
+*.dll
+*.so
+*.dylib
+*.test
+*.out
+vendor/
+`,
+	"node": `node_modules/
+npm-debug.log*
+yarn-debug.log*
+yarn-error.log*
+dist/
+`,
+	"python": `__pycache__/
+*.py[cod]
+*.egg-info/
+.venv/
+dist/
+build/
+`,
+	"macos": `.DS_Store
+`,
+}
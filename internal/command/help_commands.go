@@ -0,0 +1,57 @@
+package command
+
+import "fmt"
+
+// registerHelpCommands wires up `arngit help`.
+func (r *Router) registerHelpCommands() {
+	r.register("help", handleHelp)
+}
+
+// handleHelp implements `arngit help` (lists every registered command) and
+// `arngit help <command>` (shows that command's subcommands and flags).
+func handleHelp(ctx *Context) error {
+	if len(ctx.Args) == 0 {
+		names, _ := ctx.router.commandTree()
+		ctx.Renderer.Plain("COMMANDS")
+		for _, name := range names {
+			ctx.Renderer.Plain("  " + name)
+		}
+		return nil
+	}
+
+	name := ctx.Args[0]
+	cmd, ok := ctx.router.handlers[name]
+	if !ok {
+		return fmt.Errorf("unknown command: %s", name)
+	}
+
+	ShowCommandHelp(ctx, name, cmd)
+	return nil
+}
+
+// ShowCommandHelp prints name's subcommands (if any) and accepted flags (if
+// any) to ctx's renderer.
+func ShowCommandHelp(ctx *Context, name string, cmd Command) {
+	ctx.Renderer.Plain(name)
+
+	if len(cmd.Subcommands) > 0 {
+		ctx.Renderer.Plain("SUBCOMMANDS")
+		for _, sub := range cmd.Subcommands {
+			ctx.Renderer.Plain("  " + sub)
+		}
+	}
+
+	if len(cmd.Flags) > 0 {
+		ctx.Renderer.Plain("FLAGS")
+		for _, f := range cmd.Flags {
+			line := "  --" + f.Name
+			if f.Shorthand != "" {
+				line += ", -" + f.Shorthand
+			}
+			if f.Description != "" {
+				line += "\t" + f.Description
+			}
+			ctx.Renderer.Plain(line)
+		}
+	}
+}
@@ -0,0 +1,46 @@
+package command
+
+import (
+	"io"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/buchorim/arngit/internal/core"
+)
+
+func captureStdout(t *testing.T, f func()) string {
+	t.Helper()
+	original := os.Stdout
+	r, w, err := os.Pipe()
+	if err != nil {
+		t.Fatalf("Pipe: %v", err)
+	}
+	os.Stdout = w
+	defer func() { os.Stdout = original }()
+
+	f()
+
+	w.Close()
+	out, err := io.ReadAll(r)
+	if err != nil {
+		t.Fatalf("ReadAll: %v", err)
+	}
+	return string(out)
+}
+
+func TestHelpListsPushFlags(t *testing.T) {
+	router := NewRouter(&core.Engine{Config: core.DefaultConfig()}, "test")
+
+	out := captureStdout(t, func() {
+		if err := router.Dispatch([]string{"help", "push"}); err != nil {
+			t.Fatalf("Dispatch: %v", err)
+		}
+	})
+
+	for _, want := range []string{"--force", "--set-upstream"} {
+		if !strings.Contains(out, want) {
+			t.Errorf("help push output = %q, want it to contain %q", out, want)
+		}
+	}
+}
@@ -0,0 +1,139 @@
+package command
+
+import (
+	"bufio"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+)
+
+// registerIgnoreCommands wires up the `.gitignore` helper commands.
+func (r *Router) registerIgnoreCommands() {
+	r.registerCommand(Command{
+		Name:         "ignore",
+		Handler:      handleIgnore,
+		RequiresRepo: true,
+		Subcommands:  []string{"add", "list", "check"},
+	})
+}
+
+// handleIgnore implements `arngit ignore add <language>...`,
+// `arngit ignore list`, and `arngit ignore check`.
+func handleIgnore(ctx *Context) error {
+	if len(ctx.Args) == 0 {
+		return fmt.Errorf("usage: arngit ignore <add|list|check> [language...]")
+	}
+
+	switch ctx.Args[0] {
+	case "add":
+		return ignoreAdd(ctx, ctx.Args[1:])
+	case "list":
+		return ignoreList(ctx)
+	case "check":
+		return ignoreCheck(ctx)
+	default:
+		return fmt.Errorf("unknown ignore subcommand: %s", ctx.Args[0])
+	}
+}
+
+// ignoreCheck reports tracked files that also match a .gitignore pattern,
+// and offers to untrack them.
+func ignoreCheck(ctx *Context) error {
+	files, err := ctx.Git.TrackedIgnoredFiles()
+	if err != nil {
+		return err
+	}
+	if len(files) == 0 {
+		ctx.Renderer.Success("no tracked files match .gitignore patterns")
+		return nil
+	}
+
+	for _, f := range files {
+		ctx.Renderer.Warn(f + " is tracked but matches a .gitignore pattern")
+	}
+
+	if !ctx.Confirm(fmt.Sprintf("untrack these %d file(s) (git rm --cached)?", len(files))) {
+		return nil
+	}
+
+	out, err := ctx.Git.UntrackFiles(files)
+	if err != nil {
+		return err
+	}
+	ctx.Renderer.Success(fmt.Sprintf("untracked %d file(s)", len(files)))
+	if out != "" {
+		ctx.Renderer.Plain(out)
+	}
+	return nil
+}
+
+// ignoreList prints the bundled template names, sorted, for discoverability.
+func ignoreList(ctx *Context) error {
+	names := make([]string, 0, len(gitignoreTemplates))
+	for name := range gitignoreTemplates {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		ctx.Renderer.Plain(name)
+	}
+	return nil
+}
+
+// ignoreAdd appends the requested templates' lines to .gitignore, creating
+// it if needed, and skipping any line already present so re-running with
+// the same or overlapping templates is a no-op for those lines.
+func ignoreAdd(ctx *Context, languages []string) error {
+	if len(languages) == 0 {
+		return fmt.Errorf("usage: arngit ignore add <language>...")
+	}
+
+	path := filepath.Join(ctx.Git.Dir, ".gitignore")
+	existing := map[string]bool{}
+	if data, err := os.ReadFile(path); err == nil {
+		for _, line := range strings.Split(string(data), "\n") {
+			existing[line] = true
+		}
+	} else if !os.IsNotExist(err) {
+		return err
+	}
+
+	var toAppend []string
+	for _, lang := range languages {
+		template, ok := gitignoreTemplates[strings.ToLower(lang)]
+		if !ok {
+			return fmt.Errorf("no bundled .gitignore template for %q (see `arngit ignore list`)", lang)
+		}
+		for _, line := range strings.Split(template, "\n") {
+			if line == "" || existing[line] {
+				continue
+			}
+			existing[line] = true
+			toAppend = append(toAppend, line)
+		}
+	}
+
+	if len(toAppend) == 0 {
+		ctx.Renderer.Success(".gitignore already covers the requested template(s)")
+		return nil
+	}
+
+	f, err := os.OpenFile(path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0o644)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	w := bufio.NewWriter(f)
+	for _, line := range toAppend {
+		fmt.Fprintln(w, line)
+	}
+	if err := w.Flush(); err != nil {
+		return err
+	}
+
+	ctx.Renderer.Success(fmt.Sprintf("added %d line(s) to .gitignore", len(toAppend)))
+	return nil
+}
@@ -0,0 +1,132 @@
+package command
+
+import (
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/buchorim/arngit/internal/core"
+)
+
+func setupIgnoreTestRepo(t *testing.T) string {
+	t.Helper()
+	if _, err := exec.LookPath("git"); err != nil {
+		t.Skip("git not installed")
+	}
+	dir := t.TempDir()
+	if out, err := exec.Command("git", "init", "-q", dir).CombinedOutput(); err != nil {
+		t.Fatalf("git init: %v: %s", err, out)
+	}
+	return dir
+}
+
+func TestIgnoreAddAppendsTemplate(t *testing.T) {
+	dir := setupIgnoreTestRepo(t)
+	chdir(t, dir)
+	router := NewRouter(&core.Engine{Config: core.DefaultConfig()}, "test")
+
+	if err := router.Dispatch([]string{"ignore", "add", "go"}); err != nil {
+		t.Fatalf("ignore add: %v", err)
+	}
+
+	data, err := os.ReadFile(filepath.Join(dir, ".gitignore"))
+	if err != nil {
+		t.Fatalf("reading .gitignore: %v", err)
+	}
+	if !strings.Contains(string(data), "vendor/") {
+		t.Fatalf(".gitignore = %q, want it to contain the go template", data)
+	}
+}
+
+func TestIgnoreAddDedupsExistingLines(t *testing.T) {
+	dir := setupIgnoreTestRepo(t)
+	if err := os.WriteFile(filepath.Join(dir, ".gitignore"), []byte("vendor/\ncustom.txt\n"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	chdir(t, dir)
+	router := NewRouter(&core.Engine{Config: core.DefaultConfig()}, "test")
+
+	if err := router.Dispatch([]string{"ignore", "add", "go"}); err != nil {
+		t.Fatalf("ignore add: %v", err)
+	}
+
+	data, err := os.ReadFile(filepath.Join(dir, ".gitignore"))
+	if err != nil {
+		t.Fatalf("reading .gitignore: %v", err)
+	}
+	if strings.Count(string(data), "vendor/") != 1 {
+		t.Fatalf(".gitignore = %q, want vendor/ to appear exactly once", data)
+	}
+	if !strings.Contains(string(data), "custom.txt") {
+		t.Fatalf(".gitignore = %q, want the pre-existing custom.txt line preserved", data)
+	}
+}
+
+func TestIgnoreAddRejectsUnknownLanguage(t *testing.T) {
+	dir := setupIgnoreTestRepo(t)
+	chdir(t, dir)
+	router := NewRouter(&core.Engine{Config: core.DefaultConfig()}, "test")
+
+	if err := router.Dispatch([]string{"ignore", "add", "not-a-real-language"}); err == nil {
+		t.Fatal("expected an error for an unknown template")
+	}
+}
+
+func TestIgnoreCheckUntracksMatchingFile(t *testing.T) {
+	dir := setupIgnoreTestRepo(t)
+	run := func(args ...string) {
+		cmd := exec.Command("git", args...)
+		cmd.Dir = dir
+		if out, err := cmd.CombinedOutput(); err != nil {
+			t.Fatalf("git %v: %v: %s", args, err, out)
+		}
+	}
+	run("config", "user.email", "test@example.com")
+	run("config", "user.name", "test")
+
+	if err := os.WriteFile(filepath.Join(dir, "build.log"), []byte("log"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	run("add", "build.log")
+	run("commit", "-q", "-m", "accidentally track build.log")
+
+	if err := os.WriteFile(filepath.Join(dir, ".gitignore"), []byte("*.log\n"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	chdir(t, dir)
+	router := NewRouter(&core.Engine{Config: core.DefaultConfig()}, "test")
+
+	if err := router.Dispatch([]string{"ignore", "check", "--yes"}); err != nil {
+		t.Fatalf("ignore check: %v", err)
+	}
+
+	out, err := exec.Command("git", "-C", dir, "ls-files").CombinedOutput()
+	if err != nil {
+		t.Fatalf("ls-files: %v: %s", err, out)
+	}
+	if strings.Contains(string(out), "build.log") {
+		t.Fatalf("expected build.log to be untracked, ls-files = %q", out)
+	}
+	if _, err := os.Stat(filepath.Join(dir, "build.log")); err != nil {
+		t.Fatalf("expected build.log to remain on disk: %v", err)
+	}
+}
+
+func TestIgnoreListPrintsBundledTemplates(t *testing.T) {
+	dir := setupIgnoreTestRepo(t)
+	chdir(t, dir)
+	router := NewRouter(&core.Engine{Config: core.DefaultConfig()}, "test")
+
+	out := captureStdout(t, func() {
+		if err := router.Dispatch([]string{"ignore", "list"}); err != nil {
+			t.Fatalf("ignore list: %v", err)
+		}
+	})
+
+	if !strings.Contains(out, "go") {
+		t.Fatalf("ignore list output = %q, want it to include \"go\"", out)
+	}
+}
@@ -0,0 +1,71 @@
+package command
+
+import (
+	"fmt"
+
+	"github.com/buchorim/arngit/internal/github"
+)
+
+// inboxFlags declares the flags `arngit inbox` accepts.
+var inboxFlags = []FlagSpec{
+	{Name: "all", Description: "Show already-read notifications too"},
+}
+
+// registerInboxCommands wires up `arngit inbox`.
+func (r *Router) registerInboxCommands() {
+	r.registerCommand(Command{
+		Name:            "inbox",
+		Handler:         handleInbox,
+		RequiresAccount: true,
+		Subcommands:     []string{"read"},
+		Flags:           inboxFlags,
+	})
+}
+
+// handleInbox shows the authenticated user's notifications by default, or
+// marks one read with `inbox read <id>`.
+func handleInbox(ctx *Context) error {
+	client, err := ctx.Engine.GitHubClient()
+	if err != nil {
+		return err
+	}
+
+	if len(ctx.Args) > 0 && ctx.Args[0] == "read" {
+		return inboxRead(ctx, client, ctx.Args[1:])
+	}
+
+	flags := parseFlags(inboxFlags, ctx.Args)
+	result, err := client.ListNotifications(flags.Bool["all"])
+	if err != nil {
+		return wrapGitHubError(err)
+	}
+	renderNotifications(ctx, result.Notifications)
+	return nil
+}
+
+func renderNotifications(ctx *Context, notifications []github.Notification) {
+	if len(notifications) == 0 {
+		ctx.Renderer.Plain("no notifications")
+		return
+	}
+	for _, n := range notifications {
+		ctx.Renderer.Plain(fmt.Sprintf("%s  %s  %s  %s", n.ID, n.Repository.FullName, n.Reason, n.Subject.Title))
+	}
+}
+
+func inboxRead(ctx *Context, client *github.Client, args []string) error {
+	if len(args) != 1 {
+		return fmt.Errorf("usage: arngit inbox read <id>")
+	}
+	id := args[0]
+
+	if ctx.DryRun {
+		ctx.Renderer.Info(fmt.Sprintf("dry run: would mark notification %s read", id))
+		return nil
+	}
+	if err := client.MarkNotificationRead(id); err != nil {
+		return wrapGitHubError(err)
+	}
+	ctx.Renderer.Success(fmt.Sprintf("marked notification %s read", id))
+	return nil
+}
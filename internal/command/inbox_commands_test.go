@@ -0,0 +1,61 @@
+package command
+
+import (
+	"errors"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/buchorim/arngit/internal/core"
+	"github.com/buchorim/arngit/internal/github"
+	"github.com/buchorim/arngit/internal/ui"
+)
+
+func TestRenderNotificationsPrintsFields(t *testing.T) {
+	ctx := &Context{Renderer: ui.NewRenderer(false, "")}
+	notifications := []github.Notification{{ID: "1", Reason: "mention"}}
+	notifications[0].Repository.FullName = "acme/widgets"
+	notifications[0].Subject.Title = "Fix the bug"
+
+	out := captureStdout(t, func() {
+		renderNotifications(ctx, notifications)
+	})
+	if !strings.Contains(out, "acme/widgets") || !strings.Contains(out, "mention") || !strings.Contains(out, "Fix the bug") {
+		t.Errorf("output missing expected fields: %q", out)
+	}
+}
+
+func TestRenderNotificationsReportsEmpty(t *testing.T) {
+	ctx := &Context{Renderer: ui.NewRenderer(false, "")}
+	out := captureStdout(t, func() {
+		renderNotifications(ctx, nil)
+	})
+	if !strings.Contains(out, "no notifications") {
+		t.Errorf("output = %q", out)
+	}
+}
+
+func TestInboxRequiresAccountDeclinesWithoutAccount(t *testing.T) {
+	readEnd, writeEnd, err := os.Pipe()
+	if err != nil {
+		t.Fatalf("Pipe: %v", err)
+	}
+	writeEnd.Close()
+	originalStdin := os.Stdin
+	os.Stdin = readEnd
+	defer func() { os.Stdin = originalStdin }()
+
+	router := NewRouter(&core.Engine{Config: core.DefaultConfig(), Accounts: &core.AccountStore{}}, "test")
+	err = router.Dispatch([]string{"inbox"})
+	if !errors.Is(err, core.ErrNoAccount) {
+		t.Errorf("err = %v, want ErrNoAccount", err)
+	}
+}
+
+func TestInboxReadRejectsWrongArgCount(t *testing.T) {
+	router := NewRouter(&core.Engine{Config: core.DefaultConfig(), Accounts: &core.AccountStore{Active: "work"}}, "test")
+	err := router.Dispatch([]string{"inbox", "read"})
+	if err == nil {
+		t.Fatal("expected an error for a missing thread id")
+	}
+}
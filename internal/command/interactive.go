@@ -0,0 +1,66 @@
+package command
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/chzyer/readline"
+)
+
+// historyFileName is the file RunInteractive persists command history to,
+// relative to the directory it's given.
+const historyFileName = "history"
+
+// RunInteractive starts a REPL over router: a line editor with persistent,
+// arrow-key-navigable history (stored under historyDir) and Ctrl+R search,
+// dispatching each submitted line as a normal command. "exit"/"quit" ends
+// the loop; "clear" clears the screen without dispatching anything.
+func RunInteractive(router *Router, historyDir string) error {
+	if err := os.MkdirAll(historyDir, 0o700); err != nil {
+		return err
+	}
+
+	rl, err := readline.NewEx(&readline.Config{
+		Prompt:       "arngit> ",
+		HistoryFile:  filepath.Join(historyDir, historyFileName),
+		AutoComplete: router.completer(),
+		Stdin:        os.Stdin,
+		Stdout:       os.Stdout,
+	})
+	if err != nil {
+		return err
+	}
+	defer rl.Close()
+
+	router.ShowDashboard()
+
+	for {
+		line, err := rl.Readline()
+		if err != nil {
+			// io.EOF (Ctrl+D) and readline.ErrInterrupt (Ctrl+C) both end
+			// the session rather than erroring out.
+			fmt.Println("Goodbye!")
+			return nil
+		}
+
+		line = strings.TrimSpace(line)
+		if line == "" {
+			continue
+		}
+
+		switch line {
+		case "exit", "quit":
+			fmt.Println("Goodbye!")
+			return nil
+		case "clear":
+			fmt.Print("\033[H\033[2J")
+			continue
+		}
+
+		if err := router.Dispatch(strings.Fields(line)); err != nil {
+			fmt.Fprintln(os.Stderr, "arngit:", err)
+		}
+	}
+}
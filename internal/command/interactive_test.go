@@ -0,0 +1,127 @@
+package command
+
+import (
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/buchorim/arngit/internal/core"
+)
+
+func TestRunInteractivePersistsHistory(t *testing.T) {
+	historyDir := t.TempDir()
+
+	router := NewRouter(&core.Engine{Config: core.DefaultConfig()}, "test")
+
+	originalStdin, originalStdout := os.Stdin, os.Stdout
+	stdinR, stdinW, err := os.Pipe()
+	if err != nil {
+		t.Fatalf("Pipe: %v", err)
+	}
+	stdoutR, stdoutW, err := os.Pipe()
+	if err != nil {
+		t.Fatalf("Pipe: %v", err)
+	}
+	os.Stdin, os.Stdout = stdinR, stdoutW
+	defer func() { os.Stdin, os.Stdout = originalStdin, originalStdout }()
+
+	go func() {
+		io.Copy(io.Discard, stdoutR)
+	}()
+
+	go func() {
+		io.WriteString(stdinW, "unknown-command\nexit\n")
+		stdinW.Close()
+	}()
+
+	if err := RunInteractive(router, historyDir); err != nil {
+		t.Fatalf("RunInteractive: %v", err)
+	}
+	stdoutW.Close()
+
+	data, err := os.ReadFile(filepath.Join(historyDir, historyFileName))
+	if err != nil {
+		t.Fatalf("reading history file: %v", err)
+	}
+	if !strings.Contains(string(data), "unknown-command") {
+		t.Errorf("history file = %q, want it to contain the submitted line", data)
+	}
+}
+
+func TestRunInteractiveExitsPromptlyOnImmediateEOF(t *testing.T) {
+	historyDir := t.TempDir()
+	router := NewRouter(&core.Engine{Config: core.DefaultConfig()}, "test")
+
+	originalStdin, originalStdout := os.Stdin, os.Stdout
+	stdinR, stdinW, err := os.Pipe()
+	if err != nil {
+		t.Fatalf("Pipe: %v", err)
+	}
+	stdoutR, stdoutW, err := os.Pipe()
+	if err != nil {
+		t.Fatalf("Pipe: %v", err)
+	}
+	os.Stdin, os.Stdout = stdinR, stdoutW
+	defer func() { os.Stdin, os.Stdout = originalStdin, originalStdout }()
+
+	var output strings.Builder
+	outputDone := make(chan struct{})
+	go func() {
+		io.Copy(&output, stdoutR)
+		close(outputDone)
+	}()
+
+	// Close stdin immediately, with no input at all, simulating an already
+	// exhausted pipe (e.g. `echo -n | arngit`) or an immediate Ctrl+D.
+	stdinW.Close()
+
+	done := make(chan error, 1)
+	go func() { done <- RunInteractive(router, historyDir) }()
+
+	select {
+	case err := <-done:
+		if err != nil {
+			t.Fatalf("RunInteractive should return nil at EOF, got: %v", err)
+		}
+	case <-time.After(2 * time.Second):
+		t.Fatal("RunInteractive did not return promptly on immediate stdin EOF")
+	}
+
+	stdoutW.Close()
+	<-outputDone
+
+	if !strings.Contains(output.String(), "Goodbye!") {
+		t.Errorf("output = %q, want it to contain a Goodbye! message on EOF", output.String())
+	}
+}
+
+func TestRunInteractiveHandlesUnknownCommandWithoutExiting(t *testing.T) {
+	historyDir := t.TempDir()
+	router := NewRouter(&core.Engine{Config: core.DefaultConfig()}, "test")
+
+	originalStdin, originalStdout := os.Stdin, os.Stdout
+	stdinR, stdinW, err := os.Pipe()
+	if err != nil {
+		t.Fatalf("Pipe: %v", err)
+	}
+	stdoutR, stdoutW, err := os.Pipe()
+	if err != nil {
+		t.Fatalf("Pipe: %v", err)
+	}
+	os.Stdin, os.Stdout = stdinR, stdoutW
+	defer func() { os.Stdin, os.Stdout = originalStdin, originalStdout }()
+
+	go func() { io.Copy(io.Discard, stdoutR) }()
+	go func() {
+		io.WriteString(stdinW, "bogus\nbogus\n")
+		stdinW.Close()
+	}()
+
+	if err := RunInteractive(router, historyDir); err != nil {
+		t.Fatalf("RunInteractive should return nil at EOF, got: %v", err)
+	}
+	stdoutW.Close()
+}
@@ -0,0 +1,34 @@
+package command
+
+import "fmt"
+
+// registerJournalCommands wires up `arngit journal`.
+func (r *Router) registerJournalCommands() {
+	r.registerRepoCommand("journal", handleJournal)
+}
+
+// handleJournal implements `arngit journal`: print the repo's recorded
+// mutating commands, oldest first, for forensics or to see what `undo`
+// would reverse.
+func handleJournal(ctx *Context) error {
+	entries, err := ctx.Git.ReadJournal()
+	if err != nil {
+		return err
+	}
+	if len(entries) == 0 {
+		ctx.Renderer.Plain("no journaled commands for this repo yet")
+		return nil
+	}
+
+	for _, e := range entries {
+		line := fmt.Sprintf("%s  %s", e.Time.Format("2006-01-02 15:04:05"), e.Command)
+		if len(e.Args) > 0 {
+			line += fmt.Sprintf(" %v", e.Args)
+		}
+		if e.Head != "" {
+			line += fmt.Sprintf("  -> %s", shortHash(e.Head))
+		}
+		ctx.Renderer.Plain(line)
+	}
+	return nil
+}
@@ -0,0 +1,91 @@
+package command
+
+import (
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/buchorim/arngit/internal/core"
+)
+
+// setupJournalTestRepo builds a clone of a bare origin, so push has
+// somewhere real to push to.
+func setupJournalTestRepo(t *testing.T) string {
+	t.Helper()
+	if _, err := exec.LookPath("git"); err != nil {
+		t.Skip("git not installed")
+	}
+
+	origin := t.TempDir()
+	if out, err := exec.Command("git", "init", "-q", "-b", "main", "--bare", origin).CombinedOutput(); err != nil {
+		t.Fatalf("git init --bare: %v: %s", err, out)
+	}
+
+	dir := t.TempDir()
+	run := func(args ...string) {
+		cmd := exec.Command("git", args...)
+		cmd.Dir = dir
+		if out, err := cmd.CombinedOutput(); err != nil {
+			t.Fatalf("git %v: %v: %s", args, err, out)
+		}
+	}
+	run("clone", "-q", origin, ".")
+	run("config", "user.email", "test@example.com")
+	run("config", "user.name", "test")
+	if err := os.WriteFile(filepath.Join(dir, "base.txt"), []byte("base\n"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	run("add", ".")
+	run("commit", "-q", "-m", "base")
+	run("push", "-q", "-u", "origin", "main")
+
+	return dir
+}
+
+func TestJournalRecordsCommitAndPush(t *testing.T) {
+	dir := setupJournalTestRepo(t)
+	chdir(t, dir)
+
+	router := NewRouter(&core.Engine{Config: core.DefaultConfig()}, "test")
+	if err := os.WriteFile(filepath.Join(dir, "feature.txt"), []byte("feature\n"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	if err := router.Dispatch([]string{"add", "feature.txt"}); err != nil {
+		t.Fatalf("add: %v", err)
+	}
+	if err := router.Dispatch([]string{"commit", "-m", "add feature"}); err != nil {
+		t.Fatalf("commit: %v", err)
+	}
+	if err := router.Dispatch([]string{"push"}); err != nil {
+		t.Fatalf("push: %v", err)
+	}
+
+	out := captureStdout(t, func() {
+		if err := router.Dispatch([]string{"journal"}); err != nil {
+			t.Fatalf("journal: %v", err)
+		}
+	})
+
+	for _, want := range []string{"add", "commit", "push"} {
+		if !strings.Contains(out, want) {
+			t.Fatalf("expected journal output to mention %q, got:\n%s", want, out)
+		}
+	}
+}
+
+func TestJournalReportsEmptyBeforeAnyMutatingCommand(t *testing.T) {
+	dir := setupJournalTestRepo(t)
+	chdir(t, dir)
+
+	router := NewRouter(&core.Engine{Config: core.DefaultConfig()}, "test")
+	out := captureStdout(t, func() {
+		if err := router.Dispatch([]string{"journal"}); err != nil {
+			t.Fatalf("journal: %v", err)
+		}
+	})
+	if !strings.Contains(out, "no journaled commands") {
+		t.Fatalf("expected an empty-journal message, got:\n%s", out)
+	}
+}
@@ -0,0 +1,186 @@
+package command
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/buchorim/arngit/internal/github"
+)
+
+// labelFlags declares the flags `arngit label create` accepts.
+var labelFlags = []FlagSpec{
+	{Name: "color", Description: "Label color as a 6-digit hex code (default: ededed)", TakesValue: true},
+	{Name: "description", Description: "Label description", TakesValue: true},
+}
+
+// registerLabelCommands wires up `arngit label`.
+func (r *Router) registerLabelCommands() {
+	r.registerCommand(Command{
+		Name:            "label",
+		Handler:         handleLabel,
+		RequiresRepo:    true,
+		RequiresAccount: true,
+		Subcommands:     []string{"list", "create", "add", "remove"},
+		Flags:           labelFlags,
+	})
+}
+
+// handleLabel dispatches `arngit label <list|create|add|remove>`.
+func handleLabel(ctx *Context) error {
+	if len(ctx.Args) == 0 {
+		return fmt.Errorf("usage: arngit label <list|create <name>|add <number> <label,...>|remove <number> <label>>")
+	}
+	sub, rest := ctx.Args[0], ctx.Args[1:]
+
+	owner, repo, err := currentOwnerRepo(ctx)
+	if err != nil {
+		return err
+	}
+	client, err := ctx.Engine.GitHubClient()
+	if err != nil {
+		return err
+	}
+
+	switch sub {
+	case "list":
+		return labelList(ctx, client, owner, repo)
+	case "create":
+		return labelCreate(ctx, client, owner, repo, rest)
+	case "add":
+		return labelAdd(ctx, client, owner, repo, rest)
+	case "remove":
+		return labelRemove(ctx, client, owner, repo, rest)
+	default:
+		return fmt.Errorf("unknown label subcommand: %s", sub)
+	}
+}
+
+func labelList(ctx *Context, client *github.Client, owner, repo string) error {
+	labels, err := client.ListLabels(owner, repo)
+	if err != nil {
+		return wrapGitHubError(err)
+	}
+	if len(labels) == 0 {
+		ctx.Renderer.Plain("no labels defined")
+		return nil
+	}
+	for _, l := range labels {
+		ctx.Renderer.Plain(fmt.Sprintf("%s  #%s  %s", l.Name, l.Color, l.Description))
+	}
+	return nil
+}
+
+// hexColorPattern matches a bare 6-digit hex color, the form GitHub's API
+// expects (no leading "#").
+var hexColorPattern = regexp.MustCompile(`^[0-9a-fA-F]{6}$`)
+
+// validateLabelColor strips an optional leading "#" and requires the
+// remainder to be a 6-digit hex code.
+func validateLabelColor(raw string) (string, error) {
+	color := strings.TrimPrefix(raw, "#")
+	if !hexColorPattern.MatchString(color) {
+		return "", fmt.Errorf("invalid label color %q: must be a 6-digit hex code", raw)
+	}
+	return strings.ToLower(color), nil
+}
+
+func labelCreate(ctx *Context, client *github.Client, owner, repo string, args []string) error {
+	flags := parseFlags(labelFlags, args)
+	if len(flags.Positional) != 1 {
+		return fmt.Errorf("usage: arngit label create <name> [--color=ededed] [--description=...]")
+	}
+	name := flags.Positional[0]
+
+	rawColor := flags.Value["color"]
+	if rawColor == "" {
+		rawColor = "ededed"
+	}
+	color, err := validateLabelColor(rawColor)
+	if err != nil {
+		return err
+	}
+
+	if ctx.DryRun {
+		ctx.Renderer.Info(fmt.Sprintf("dry run: would create label %s (#%s)", name, color))
+		return nil
+	}
+
+	label, err := client.CreateLabel(owner, repo, github.CreateLabelRequest{
+		Name:        name,
+		Color:       color,
+		Description: flags.Value["description"],
+	})
+	if err != nil {
+		return wrapGitHubError(err)
+	}
+	ctx.Renderer.Success(fmt.Sprintf("created label %s (#%s)", label.Name, label.Color))
+	return nil
+}
+
+// splitLabelList splits a comma-separated --label value, discarding blank
+// entries.
+func splitLabelList(raw string) []string {
+	var labels []string
+	for _, l := range strings.Split(raw, ",") {
+		l = strings.TrimSpace(l)
+		if l == "" {
+			continue
+		}
+		labels = append(labels, l)
+	}
+	return labels
+}
+
+func labelAdd(ctx *Context, client *github.Client, owner, repo string, args []string) error {
+	if len(args) != 2 {
+		return fmt.Errorf("usage: arngit label add <number> <label,label,...>")
+	}
+	number, err := strconv.Atoi(args[0])
+	if err != nil {
+		return fmt.Errorf("invalid issue/pr number %q: %w", args[0], err)
+	}
+	labels := splitLabelList(args[1])
+	if len(labels) == 0 {
+		return fmt.Errorf("at least one label is required")
+	}
+
+	if ctx.DryRun {
+		ctx.Renderer.Info(fmt.Sprintf("dry run: would add labels %s to #%d", strings.Join(labels, ","), number))
+		return nil
+	}
+
+	result, err := client.AddLabelsToIssue(owner, repo, number, labels)
+	if err != nil {
+		return wrapGitHubError(err)
+	}
+	names := make([]string, len(result))
+	for i, l := range result {
+		names[i] = l.Name
+	}
+	ctx.Renderer.Success(fmt.Sprintf("#%d labels: %s", number, strings.Join(names, ", ")))
+	return nil
+}
+
+func labelRemove(ctx *Context, client *github.Client, owner, repo string, args []string) error {
+	if len(args) != 2 {
+		return fmt.Errorf("usage: arngit label remove <number> <label>")
+	}
+	number, err := strconv.Atoi(args[0])
+	if err != nil {
+		return fmt.Errorf("invalid issue/pr number %q: %w", args[0], err)
+	}
+	name := args[1]
+
+	if ctx.DryRun {
+		ctx.Renderer.Info(fmt.Sprintf("dry run: would remove label %s from #%d", name, number))
+		return nil
+	}
+
+	if err := client.RemoveLabel(owner, repo, number, name); err != nil {
+		return wrapGitHubError(err)
+	}
+	ctx.Renderer.Success(fmt.Sprintf("removed label %s from #%d", name, number))
+	return nil
+}
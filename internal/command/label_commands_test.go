@@ -0,0 +1,94 @@
+package command
+
+import (
+	"errors"
+	"os"
+	"os/exec"
+	"testing"
+
+	"github.com/buchorim/arngit/internal/core"
+)
+
+func TestValidateLabelColor(t *testing.T) {
+	tests := []struct {
+		name    string
+		color   string
+		want    string
+		wantErr bool
+	}{
+		{"bare hex", "ffcc00", "ffcc00", false},
+		{"leading hash", "#FFCC00", "ffcc00", false},
+		{"too short", "fff", "", true},
+		{"not hex", "zzzzzz", "", true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := validateLabelColor(tt.color)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("validateLabelColor(%q) error = %v, wantErr %v", tt.color, err, tt.wantErr)
+			}
+			if err == nil && got != tt.want {
+				t.Errorf("validateLabelColor(%q) = %q, want %q", tt.color, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestSplitLabelList(t *testing.T) {
+	got := splitLabelList("bug, priority ,,docs")
+	want := []string{"bug", "priority", "docs"}
+	if len(got) != len(want) {
+		t.Fatalf("splitLabelList = %v, want %v", got, want)
+	}
+	for i := range got {
+		if got[i] != want[i] {
+			t.Fatalf("splitLabelList = %v, want %v", got, want)
+		}
+	}
+}
+
+func TestLabelRequiresAccountDeclinesWithoutAccount(t *testing.T) {
+	if _, err := exec.LookPath("git"); err != nil {
+		t.Skip("git not installed")
+	}
+	dir := t.TempDir()
+	if out, err := exec.Command("git", "init", "-q", dir).CombinedOutput(); err != nil {
+		t.Fatalf("git init: %v: %s", err, out)
+	}
+	chdir(t, dir)
+
+	readEnd, writeEnd, err := os.Pipe()
+	if err != nil {
+		t.Fatalf("Pipe: %v", err)
+	}
+	writeEnd.Close()
+	originalStdin := os.Stdin
+	os.Stdin = readEnd
+	defer func() { os.Stdin = originalStdin }()
+
+	router := NewRouter(&core.Engine{Config: core.DefaultConfig(), Accounts: &core.AccountStore{}}, "test")
+	err = router.Dispatch([]string{"label", "list"})
+	if !errors.Is(err, core.ErrNoAccount) {
+		t.Errorf("err = %v, want ErrNoAccount", err)
+	}
+}
+
+func TestLabelCreateRejectsInvalidColor(t *testing.T) {
+	if _, err := exec.LookPath("git"); err != nil {
+		t.Skip("git not installed")
+	}
+	dir := t.TempDir()
+	if out, err := exec.Command("git", "init", "-q", dir).CombinedOutput(); err != nil {
+		t.Fatalf("git init: %v: %s", err, out)
+	}
+	if out, err := exec.Command("git", "-C", dir, "remote", "add", "origin", "git@github.com:acme/widgets.git").CombinedOutput(); err != nil {
+		t.Fatalf("git remote add: %v: %s", err, out)
+	}
+	chdir(t, dir)
+
+	router := NewRouter(&core.Engine{Config: core.DefaultConfig(), Accounts: &core.AccountStore{Active: "work"}}, "test")
+	err := router.Dispatch([]string{"label", "create", "priority", "--color=nothex"})
+	if err == nil {
+		t.Fatal("expected an error for an invalid label color")
+	}
+}
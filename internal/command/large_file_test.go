@@ -0,0 +1,78 @@
+package command
+
+import (
+	"bytes"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/buchorim/arngit/internal/core"
+	"github.com/buchorim/arngit/internal/git"
+)
+
+func TestCommitWarnsOnLargeStagedFile(t *testing.T) {
+	dir, _ := setupFixupTestRepo(t)
+
+	big := bytes.Repeat([]byte("x"), 2048)
+	if err := os.WriteFile(filepath.Join(dir, "big.bin"), big, 0o644); err != nil {
+		t.Fatal(err)
+	}
+	chdir(t, dir)
+	router := NewRouter(&core.Engine{Config: core.DefaultConfig()}, "test")
+	router.engine.Config.MaxFileSize = 1024
+
+	if err := router.Dispatch([]string{"add", "."}); err != nil {
+		t.Fatalf("add: %v", err)
+	}
+
+	out := captureStdout(t, func() {
+		if err := router.Dispatch([]string{"commit", "-m", "add big file", "--yes"}); err != nil {
+			t.Fatalf("commit: %v", err)
+		}
+	})
+
+	if !strings.Contains(out, "big.bin") {
+		t.Errorf("expected a warning mentioning big.bin, got %q", out)
+	}
+
+	log, err := exec.Command("git", "-C", dir, "log", "-1", "--pretty=%s").CombinedOutput()
+	if err != nil {
+		t.Fatalf("log: %v: %s", err, log)
+	}
+	if got, want := strings.TrimSpace(string(log)), "add big file"; got != want {
+		t.Fatalf("commit message = %q, want %q", got, want)
+	}
+}
+
+func TestStagedFilesReportsSizes(t *testing.T) {
+	dir, _ := setupFixupTestRepo(t)
+
+	content := []byte("hello")
+	if err := os.WriteFile(filepath.Join(dir, "new.txt"), content, 0o644); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := exec.Command("git", "-C", dir, "add", "new.txt").CombinedOutput(); err != nil {
+		t.Fatal(err)
+	}
+
+	gitSvc := git.NewService(dir)
+	files, err := gitSvc.StagedFiles()
+	if err != nil {
+		t.Fatalf("StagedFiles: %v", err)
+	}
+
+	var found bool
+	for _, f := range files {
+		if f.Path == "new.txt" {
+			found = true
+			if f.Size != int64(len(content)) {
+				t.Errorf("new.txt size = %d, want %d", f.Size, len(content))
+			}
+		}
+	}
+	if !found {
+		t.Fatal("expected new.txt in StagedFiles output")
+	}
+}
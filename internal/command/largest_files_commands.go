@@ -0,0 +1,38 @@
+package command
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/buchorim/arngit/internal/analytics"
+)
+
+// registerLargestFilesCommands wires up `arngit largest-files`.
+func (r *Router) registerLargestFilesCommands() {
+	r.registerRepoCommand("largest-files", handleLargestFiles)
+}
+
+// handleLargestFiles implements `arngit largest-files [-n N]`, listing the
+// largest blobs ever committed, including ones since deleted.
+func handleLargestFiles(ctx *Context) error {
+	n := 10
+	for i, a := range ctx.Args {
+		if a == "-n" && i+1 < len(ctx.Args) {
+			parsed, err := strconv.Atoi(ctx.Args[i+1])
+			if err != nil {
+				return fmt.Errorf("invalid -n: %w", err)
+			}
+			n = parsed
+		}
+	}
+
+	blobs, err := analytics.LargestBlobs(ctx.Git, n)
+	if err != nil {
+		return err
+	}
+
+	for _, b := range blobs {
+		ctx.Renderer.Plain(fmt.Sprintf("%10s  %s  %s", humanSize(b.Size), b.Hash[:min(8, len(b.Hash))], b.Path))
+	}
+	return nil
+}
@@ -0,0 +1,76 @@
+package command
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/buchorim/arngit/internal/logging"
+)
+
+// registerLogsCommands wires up `arngit logs`.
+func (r *Router) registerLogsCommands() {
+	r.register("logs", handleLogs)
+}
+
+// handleLogs implements `arngit logs [--level=LEVEL] [--since=DURATION]` and
+// `arngit logs clear`. --level filters to that severity, an exact match
+// (INFO/WARN/ERROR). --since accepts a Go duration like "1h" or "30m",
+// relative to now.
+func handleLogs(ctx *Context) error {
+	if len(ctx.Args) > 0 && ctx.Args[0] == "clear" {
+		return logsClear(ctx)
+	}
+
+	var level string
+	var since time.Duration
+
+	for _, a := range ctx.Args {
+		switch {
+		case strings.HasPrefix(a, "--level="):
+			level = strings.ToUpper(strings.TrimPrefix(a, "--level="))
+		case strings.HasPrefix(a, "--since="):
+			d, err := time.ParseDuration(strings.TrimPrefix(a, "--since="))
+			if err != nil {
+				return fmt.Errorf("invalid --since duration: %w", err)
+			}
+			since = d
+		default:
+			return fmt.Errorf("unknown logs flag: %s", a)
+		}
+	}
+
+	lines, err := ctx.Engine.Logger.Lines()
+	if err != nil {
+		return err
+	}
+
+	var cutoff time.Time
+	if since > 0 {
+		cutoff = time.Now().Add(-since)
+	}
+
+	for _, line := range lines {
+		entry, ok := logging.ParseLine(line)
+		if !ok {
+			continue
+		}
+		if level != "" && string(entry.Level) != level {
+			continue
+		}
+		if !cutoff.IsZero() && entry.Time.Before(cutoff) {
+			continue
+		}
+		ctx.Renderer.Plain(line)
+	}
+
+	return nil
+}
+
+func logsClear(ctx *Context) error {
+	if err := ctx.Engine.Logger.Clear(); err != nil {
+		return err
+	}
+	ctx.Renderer.Success("cleared logs")
+	return nil
+}
@@ -0,0 +1,153 @@
+package command
+
+import (
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/buchorim/arngit/internal/github"
+)
+
+// milestoneFlags declares the flags `arngit milestone create` accepts.
+var milestoneFlags = []FlagSpec{
+	{Name: "description", Description: "Milestone description", TakesValue: true},
+	{Name: "due", Description: "Due date, as YYYY-MM-DD", TakesValue: true},
+}
+
+// registerMilestoneCommands wires up `arngit milestone`.
+func (r *Router) registerMilestoneCommands() {
+	r.registerCommand(Command{
+		Name:            "milestone",
+		Handler:         handleMilestone,
+		RequiresRepo:    true,
+		RequiresAccount: true,
+		Subcommands:     []string{"list", "create", "close"},
+		Flags:           milestoneFlags,
+	})
+}
+
+// handleMilestone dispatches `arngit milestone <list|create|close>`.
+func handleMilestone(ctx *Context) error {
+	if len(ctx.Args) == 0 {
+		return fmt.Errorf("usage: arngit milestone <list|create <title>|close <number>>")
+	}
+	sub, rest := ctx.Args[0], ctx.Args[1:]
+
+	owner, repo, err := currentOwnerRepo(ctx)
+	if err != nil {
+		return err
+	}
+	client, err := ctx.Engine.GitHubClient()
+	if err != nil {
+		return err
+	}
+
+	switch sub {
+	case "list":
+		return milestoneList(ctx, client, owner, repo)
+	case "create":
+		return milestoneCreate(ctx, client, owner, repo, rest)
+	case "close":
+		return milestoneClose(ctx, client, owner, repo, rest)
+	default:
+		return fmt.Errorf("unknown milestone subcommand: %s", sub)
+	}
+}
+
+func milestoneList(ctx *Context, client *github.Client, owner, repo string) error {
+	milestones, err := client.ListMilestones(owner, repo)
+	if err != nil {
+		return wrapGitHubError(err)
+	}
+	if len(milestones) == 0 {
+		ctx.Renderer.Plain("no milestones defined")
+		return nil
+	}
+	for _, m := range milestones {
+		due := m.DueOn
+		if due == "" {
+			due = "no due date"
+		}
+		ctx.Renderer.Plain(fmt.Sprintf("%d  %s  %s  due=%s", m.Number, m.Title, m.State, due))
+	}
+	return nil
+}
+
+// parseMilestoneDueDate parses a YYYY-MM-DD due date and formats it as the
+// RFC 3339 timestamp GitHub's API expects.
+func parseMilestoneDueDate(raw string) (string, error) {
+	due, err := time.Parse("2006-01-02", raw)
+	if err != nil {
+		return "", fmt.Errorf("invalid due date %q: expected YYYY-MM-DD", raw)
+	}
+	return due.Format(time.RFC3339), nil
+}
+
+func milestoneCreate(ctx *Context, client *github.Client, owner, repo string, args []string) error {
+	flags := parseFlags(milestoneFlags, args)
+	if len(flags.Positional) != 1 {
+		return fmt.Errorf("usage: arngit milestone create <title> [--due=YYYY-MM-DD] [--description=...]")
+	}
+	title := flags.Positional[0]
+
+	var dueOn string
+	if raw := flags.Value["due"]; raw != "" {
+		var err error
+		dueOn, err = parseMilestoneDueDate(raw)
+		if err != nil {
+			return err
+		}
+	}
+
+	if ctx.DryRun {
+		ctx.Renderer.Info(fmt.Sprintf("dry run: would create milestone %s", title))
+		return nil
+	}
+
+	milestone, err := client.CreateMilestone(owner, repo, github.CreateMilestoneRequest{
+		Title:       title,
+		Description: flags.Value["description"],
+		DueOn:       dueOn,
+	})
+	if err != nil {
+		return wrapGitHubError(err)
+	}
+	ctx.Renderer.Success(fmt.Sprintf("created milestone #%d: %s", milestone.Number, milestone.Title))
+	return nil
+}
+
+func milestoneClose(ctx *Context, client *github.Client, owner, repo string, args []string) error {
+	if len(args) != 1 {
+		return fmt.Errorf("usage: arngit milestone close <number>")
+	}
+	number, err := strconv.Atoi(args[0])
+	if err != nil {
+		return fmt.Errorf("invalid milestone number %q: %w", args[0], err)
+	}
+
+	if ctx.DryRun {
+		ctx.Renderer.Info(fmt.Sprintf("dry run: would close milestone #%d", number))
+		return nil
+	}
+
+	if err := client.CloseMilestone(owner, repo, number); err != nil {
+		return wrapGitHubError(err)
+	}
+	ctx.Renderer.Success(fmt.Sprintf("closed milestone #%d", number))
+	return nil
+}
+
+// resolveMilestoneNumber looks up a milestone by title, since GitHub's
+// issue-update API takes a milestone number rather than its title.
+func resolveMilestoneNumber(client *github.Client, owner, repo, title string) (int, error) {
+	milestones, err := client.ListMilestones(owner, repo)
+	if err != nil {
+		return 0, wrapGitHubError(err)
+	}
+	for _, m := range milestones {
+		if m.Title == title {
+			return m.Number, nil
+		}
+	}
+	return 0, fmt.Errorf("no milestone titled %q", title)
+}
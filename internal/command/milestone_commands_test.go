@@ -0,0 +1,80 @@
+package command
+
+import (
+	"errors"
+	"os"
+	"os/exec"
+	"testing"
+
+	"github.com/buchorim/arngit/internal/core"
+)
+
+func TestParseMilestoneDueDate(t *testing.T) {
+	tests := []struct {
+		name    string
+		raw     string
+		want    string
+		wantErr bool
+	}{
+		{"valid date", "2026-09-01", "2026-09-01T00:00:00Z", false},
+		{"garbage", "not-a-date", "", true},
+		{"wrong format", "09/01/2026", "", true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := parseMilestoneDueDate(tt.raw)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("parseMilestoneDueDate(%q) error = %v, wantErr %v", tt.raw, err, tt.wantErr)
+			}
+			if err == nil && got != tt.want {
+				t.Errorf("parseMilestoneDueDate(%q) = %q, want %q", tt.raw, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestMilestoneRequiresAccountDeclinesWithoutAccount(t *testing.T) {
+	if _, err := exec.LookPath("git"); err != nil {
+		t.Skip("git not installed")
+	}
+	dir := t.TempDir()
+	if out, err := exec.Command("git", "init", "-q", dir).CombinedOutput(); err != nil {
+		t.Fatalf("git init: %v: %s", err, out)
+	}
+	chdir(t, dir)
+
+	readEnd, writeEnd, err := os.Pipe()
+	if err != nil {
+		t.Fatalf("Pipe: %v", err)
+	}
+	writeEnd.Close()
+	originalStdin := os.Stdin
+	os.Stdin = readEnd
+	defer func() { os.Stdin = originalStdin }()
+
+	router := NewRouter(&core.Engine{Config: core.DefaultConfig(), Accounts: &core.AccountStore{}}, "test")
+	err = router.Dispatch([]string{"milestone", "list"})
+	if !errors.Is(err, core.ErrNoAccount) {
+		t.Errorf("err = %v, want ErrNoAccount", err)
+	}
+}
+
+func TestMilestoneCreateRejectsInvalidDueDate(t *testing.T) {
+	if _, err := exec.LookPath("git"); err != nil {
+		t.Skip("git not installed")
+	}
+	dir := t.TempDir()
+	if out, err := exec.Command("git", "init", "-q", dir).CombinedOutput(); err != nil {
+		t.Fatalf("git init: %v: %s", err, out)
+	}
+	if out, err := exec.Command("git", "-C", dir, "remote", "add", "origin", "git@github.com:acme/widgets.git").CombinedOutput(); err != nil {
+		t.Fatalf("git remote add: %v: %s", err, out)
+	}
+	chdir(t, dir)
+
+	router := NewRouter(&core.Engine{Config: core.DefaultConfig(), Accounts: &core.AccountStore{Active: "work"}}, "test")
+	err := router.Dispatch([]string{"milestone", "create", "v2.0", "--due=not-a-date"})
+	if err == nil {
+		t.Fatal("expected an error for an invalid due date")
+	}
+}
@@ -0,0 +1,55 @@
+package command
+
+import (
+	"os"
+	"os/exec"
+	"path/filepath"
+	"testing"
+
+	"github.com/buchorim/arngit/internal/core"
+	"github.com/buchorim/arngit/internal/git"
+)
+
+func TestCommitNoVerifyReachesGit(t *testing.T) {
+	if _, err := exec.LookPath("git"); err != nil {
+		t.Skip("git not installed")
+	}
+
+	dir := t.TempDir()
+	run := func(args ...string) {
+		cmd := exec.Command("git", args...)
+		cmd.Dir = dir
+		if out, err := cmd.CombinedOutput(); err != nil {
+			t.Fatalf("git %v: %v: %s", args, err, out)
+		}
+	}
+	run("init", "-q")
+	run("config", "user.email", "test@example.com")
+	run("config", "user.name", "test")
+
+	hookPath := filepath.Join(dir, ".git", "hooks", "pre-commit")
+	if err := os.WriteFile(hookPath, []byte("#!/bin/sh\nexit 1\n"), 0o755); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := os.WriteFile(filepath.Join(dir, "f.txt"), []byte("hi"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	run("add", ".")
+
+	chdir(t, dir)
+	router := NewRouter(&core.Engine{Config: core.DefaultConfig()}, "test")
+
+	if err := router.Dispatch([]string{"commit", "-m", "test", "--no-verify"}); err != nil {
+		t.Fatalf("commit --no-verify: %v", err)
+	}
+}
+
+func TestDescribeCommitAndPushIncludeNoVerify(t *testing.T) {
+	if got, want := describeCommit("msg", git.CommitOptions{NoVerify: true}), "git commit --no-verify -m \"msg\""; got != want {
+		t.Errorf("describeCommit = %q, want %q", got, want)
+	}
+	if got, want := describePush("origin", "main", git.PushOptions{NoVerify: true}), "git push --no-verify origin main"; got != want {
+		t.Errorf("describePush = %q, want %q", got, want)
+	}
+}
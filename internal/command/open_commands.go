@@ -0,0 +1,99 @@
+package command
+
+import (
+	"fmt"
+	"os/exec"
+	"runtime"
+
+	"github.com/buchorim/arngit/internal/git"
+)
+
+// registerOpenCommands wires up `arngit open`.
+func (r *Router) registerOpenCommands() {
+	r.registerRepoCommand("open", handleOpen)
+}
+
+// normalizeRepoURL turns a git remote URL (SSH, ssh://, or HTTPS form) into
+// its web URL, e.g. "git@github.com:owner/repo.git" and
+// "https://github.com/owner/repo.git" both become
+// "https://github.com/owner/repo".
+func normalizeRepoURL(remote string) (string, error) {
+	host, owner, repo, err := git.ParseRemoteURL(remote)
+	if err != nil {
+		return "", err
+	}
+	return fmt.Sprintf("https://%s/%s/%s", host, owner, repo), nil
+}
+
+// buildTargetURL appends the web path for subtarget (pr, issues, releases,
+// actions) to repoURL. An empty subtarget targets the repo home page.
+func buildTargetURL(repoURL, subtarget string) (string, error) {
+	switch subtarget {
+	case "":
+		return repoURL, nil
+	case "pr":
+		return repoURL + "/pulls", nil
+	case "issues":
+		return repoURL + "/issues", nil
+	case "releases":
+		return repoURL + "/releases", nil
+	case "actions":
+		return repoURL + "/actions", nil
+	default:
+		return "", fmt.Errorf("unknown open target: %s", subtarget)
+	}
+}
+
+// handleOpen implements `arngit open [pr|issues|releases|actions] [--print]`.
+func handleOpen(ctx *Context) error {
+	var subtarget string
+	printOnly := false
+
+	for _, a := range ctx.Args {
+		switch a {
+		case "--print":
+			printOnly = true
+		default:
+			if subtarget != "" {
+				return fmt.Errorf("unexpected argument: %s", a)
+			}
+			subtarget = a
+		}
+	}
+
+	remote, err := ctx.Git.RemoteURL("origin")
+	if err != nil {
+		return err
+	}
+	repoURL, err := normalizeRepoURL(remote)
+	if err != nil {
+		return err
+	}
+	target, err := buildTargetURL(repoURL, subtarget)
+	if err != nil {
+		return err
+	}
+
+	if printOnly {
+		ctx.Renderer.Plain(target)
+		return nil
+	}
+
+	if err := openInBrowser(target); err != nil {
+		return err
+	}
+	ctx.Renderer.Success("opened " + target)
+	return nil
+}
+
+// openInBrowser launches target with the OS's default browser.
+func openInBrowser(target string) error {
+	switch runtime.GOOS {
+	case "darwin":
+		return exec.Command("open", target).Run()
+	case "windows":
+		return exec.Command("rundll32", "url.dll,FileProtocolHandler", target).Run()
+	default:
+		return exec.Command("xdg-open", target).Run()
+	}
+}
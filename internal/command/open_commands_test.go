@@ -0,0 +1,57 @@
+package command
+
+import "testing"
+
+func TestNormalizeRepoURL(t *testing.T) {
+	tests := map[string]string{
+		"git@github.com:owner/repo.git":       "https://github.com/owner/repo",
+		"git@github.com:owner/repo":           "https://github.com/owner/repo",
+		"https://github.com/owner/repo.git":   "https://github.com/owner/repo",
+		"https://github.com/owner/repo":       "https://github.com/owner/repo",
+		"ssh://git@github.com/owner/repo.git": "https://github.com/owner/repo",
+	}
+
+	for input, want := range tests {
+		got, err := normalizeRepoURL(input)
+		if err != nil {
+			t.Errorf("normalizeRepoURL(%q) error: %v", input, err)
+			continue
+		}
+		if got != want {
+			t.Errorf("normalizeRepoURL(%q) = %q, want %q", input, got, want)
+		}
+	}
+}
+
+func TestNormalizeRepoURLInvalid(t *testing.T) {
+	if _, err := normalizeRepoURL("not a url"); err == nil {
+		t.Error("expected error for unrecognized remote URL")
+	}
+}
+
+func TestBuildTargetURL(t *testing.T) {
+	tests := map[string]string{
+		"":         "https://github.com/owner/repo",
+		"pr":       "https://github.com/owner/repo/pulls",
+		"issues":   "https://github.com/owner/repo/issues",
+		"releases": "https://github.com/owner/repo/releases",
+		"actions":  "https://github.com/owner/repo/actions",
+	}
+
+	for subtarget, want := range tests {
+		got, err := buildTargetURL("https://github.com/owner/repo", subtarget)
+		if err != nil {
+			t.Errorf("buildTargetURL(%q) error: %v", subtarget, err)
+			continue
+		}
+		if got != want {
+			t.Errorf("buildTargetURL(%q) = %q, want %q", subtarget, got, want)
+		}
+	}
+}
+
+func TestBuildTargetURLUnknown(t *testing.T) {
+	if _, err := buildTargetURL("https://github.com/owner/repo", "bogus"); err == nil {
+		t.Error("expected error for unknown target")
+	}
+}
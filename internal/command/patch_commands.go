@@ -0,0 +1,106 @@
+package command
+
+import "fmt"
+
+// patchFlags declares every flag any `patch` subcommand accepts.
+var patchFlags = []FlagSpec{
+	{Name: "check", Description: "Validate the patch applies cleanly without modifying the tree"},
+	{Name: "output-dir", Shorthand: "o", Description: "Directory to write exported patches into", TakesValue: true},
+}
+
+// registerPatchCommands wires up `arngit patch`.
+func (r *Router) registerPatchCommands() {
+	r.registerCommand(Command{
+		Name:         "patch",
+		Handler:      handlePatch,
+		RequiresRepo: true,
+		Subcommands:  []string{"apply", "am", "export"},
+		Flags:        patchFlags,
+	})
+}
+
+// handlePatch dispatches `arngit patch apply <file> [--check]`, `arngit
+// patch am <file>`, and `arngit patch export <range> [-o dir]`.
+func handlePatch(ctx *Context) error {
+	if len(ctx.Args) == 0 {
+		return fmt.Errorf("usage: arngit patch <apply|am|export> ...")
+	}
+
+	switch ctx.Args[0] {
+	case "apply":
+		return patchApply(ctx, ctx.Args[1:])
+	case "am":
+		return patchAM(ctx, ctx.Args[1:])
+	case "export":
+		return patchExport(ctx, ctx.Args[1:])
+	default:
+		return fmt.Errorf("unknown patch subcommand: %s", ctx.Args[0])
+	}
+}
+
+// patchApply implements `patch apply <file> [--check]`, validating or
+// applying a patch to the working tree/index.
+func patchApply(ctx *Context, args []string) error {
+	flags := parseFlags(patchFlags, args)
+	if len(flags.Positional) != 1 {
+		return fmt.Errorf("usage: arngit patch apply <file> [--check]")
+	}
+	path := flags.Positional[0]
+	check := flags.Bool["check"]
+
+	out, err := ctx.Git.ApplyPatch(path, check)
+	if err != nil {
+		return fmt.Errorf("patch does not apply cleanly: %w", err)
+	}
+
+	if check {
+		ctx.Renderer.Success(fmt.Sprintf("%s applies cleanly", path))
+	} else {
+		ctx.Renderer.Success(fmt.Sprintf("applied %s", path))
+	}
+	if out != "" {
+		ctx.Renderer.Plain(out)
+	}
+	return nil
+}
+
+// patchExport implements `patch export <range> [--output-dir=<dir>]`,
+// writing one patch file per commit in range.
+func patchExport(ctx *Context, args []string) error {
+	flags := parseFlags(patchFlags, args)
+	if len(flags.Positional) != 1 {
+		return fmt.Errorf("usage: arngit patch export <range> [--output-dir=<dir>]")
+	}
+	revRange := flags.Positional[0]
+
+	files, err := ctx.Git.FormatPatch(revRange, flags.Value["output-dir"])
+	if err != nil {
+		return err
+	}
+
+	ctx.Renderer.Success(fmt.Sprintf("exported %d patch(es)", len(files)))
+	for _, f := range files {
+		ctx.Renderer.Plain(f)
+	}
+	return nil
+}
+
+// patchAM implements `patch am <file>`, applying an mbox-formatted patch as
+// a commit.
+func patchAM(ctx *Context, args []string) error {
+	if len(args) != 1 {
+		return fmt.Errorf("usage: arngit patch am <file>")
+	}
+	path := args[0]
+
+	out, err := ctx.Git.ApplyMailbox(path)
+	if err != nil {
+		return fmt.Errorf("mailbox patch failed to apply: %w", err)
+	}
+
+	ctx.Renderer.Success(fmt.Sprintf("applied %s", path))
+	if out != "" {
+		ctx.Renderer.Plain(out)
+	}
+	return nil
+}
@@ -0,0 +1,212 @@
+package command
+
+import (
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/buchorim/arngit/internal/git"
+	"github.com/buchorim/arngit/internal/github"
+	"github.com/buchorim/arngit/internal/ui"
+)
+
+// prFlags declares the flags `arngit pr create` accepts.
+var prFlags = []FlagSpec{
+	{Name: "title", Description: "Pull request title (default: derived from the branch's commits)", TakesValue: true},
+	{Name: "body", Description: "Pull request description (default: derived from the branch's commits)", TakesValue: true},
+	{Name: "base", Description: "Base branch to merge into (default: the repo's default branch)", TakesValue: true},
+	{Name: "label", Description: "Comma-separated labels to apply to the new pull request", TakesValue: true},
+	{Name: "milestone", Description: "Title of an existing milestone to attach", TakesValue: true},
+	{Name: "assignee", Description: "Comma-separated usernames to assign", TakesValue: true},
+	{Name: "reviewer", Description: "Comma-separated usernames to request review from", TakesValue: true},
+}
+
+// registerPRCommands wires up `arngit pr`.
+func (r *Router) registerPRCommands() {
+	r.registerCommand(Command{
+		Name:            "pr",
+		Handler:         handlePR,
+		RequiresRepo:    true,
+		RequiresAccount: true,
+		Subcommands:     []string{"create"},
+		Flags:           prFlags,
+	})
+}
+
+// handlePR dispatches `arngit pr <create>`.
+func handlePR(ctx *Context) error {
+	if len(ctx.Args) == 0 {
+		return fmt.Errorf("usage: arngit pr create [--title=...] [--body=...] [--base=...] [--label=...] [--assignee=...] [--reviewer=...]")
+	}
+	switch ctx.Args[0] {
+	case "create":
+		return handlePRCreate(ctx, ctx.Args[1:])
+	default:
+		return fmt.Errorf("unknown pr subcommand: %s", ctx.Args[0])
+	}
+}
+
+// handlePRCreate pushes the current branch (after confirmation) and opens
+// a pull request into base, or points at an existing PR for this branch
+// instead of creating a duplicate.
+func handlePRCreate(ctx *Context, args []string) error {
+	flags := parseFlags(prFlags, args)
+
+	branch, err := ctx.Git.CurrentBranch()
+	if err != nil {
+		return err
+	}
+	base := flags.Value["base"]
+	if base == "" {
+		base = ctx.Engine.Config.DefaultBranch
+	}
+
+	title := flags.Value["title"]
+	body := flags.Value["body"]
+	if title == "" {
+		commits, err := ctx.Git.CommitsInRange(base, branch)
+		if err != nil {
+			return err
+		}
+		derivedTitle, derivedBody := derivePRTitleAndBody(commits, branch)
+		if derivedTitle == "" {
+			derivedTitle = ui.Prompt(os.Stdin, os.Stdout, "no commits found; enter a pull request title")
+			if derivedTitle == "" {
+				return fmt.Errorf("a pull request title is required")
+			}
+		}
+		title = derivedTitle
+		if body == "" {
+			body = derivedBody
+		}
+	}
+
+	if flags.Value["body"] == "" {
+		tmpl, err := findTemplate("pr")
+		if err != nil {
+			return err
+		}
+		if tmpl != "" {
+			body, err = editTemplate(tmpl)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	owner, repo, err := currentOwnerRepo(ctx)
+	if err != nil {
+		return err
+	}
+	client, err := ctx.Engine.GitHubClient()
+	if err != nil {
+		return err
+	}
+
+	existing, err := client.ListPRsForHead(owner, repo, branch)
+	if err != nil {
+		return wrapGitHubError(err)
+	}
+	if len(existing) > 0 {
+		ctx.Renderer.Info(fmt.Sprintf("a pull request for %s already exists: %s", branch, existing[0].HTMLURL))
+		return nil
+	}
+
+	if !ctx.Confirm(fmt.Sprintf("push %s to origin and open a pull request into %s?", branch, base)) {
+		return fmt.Errorf("pr create aborted")
+	}
+
+	if ctx.DryRun {
+		ctx.Renderer.Info(fmt.Sprintf("dry run: would push %s and open a PR into %s", branch, base))
+		return nil
+	}
+
+	if _, err := ctx.Git.Push("origin", branch, git.PushOptions{SetUpstream: true}); err != nil {
+		return fmt.Errorf("pushing %s: %w", branch, err)
+	}
+
+	pr, err := client.CreatePR(owner, repo, github.CreatePRRequest{
+		Title: title,
+		Head:  branch,
+		Base:  base,
+		Body:  body,
+	})
+	if err != nil {
+		return wrapGitHubError(err)
+	}
+	ctx.Renderer.Success(fmt.Sprintf("opened pull request #%d: %s", pr.Number, pr.HTMLURL))
+
+	if labels := splitLabelList(flags.Value["label"]); len(labels) > 0 {
+		if _, err := client.AddLabelsToIssue(owner, repo, pr.Number, labels); err != nil {
+			return wrapGitHubError(err)
+		}
+		ctx.Renderer.Success(fmt.Sprintf("added labels: %s", strings.Join(labels, ", ")))
+	}
+
+	if title := flags.Value["milestone"]; title != "" {
+		number, err := resolveMilestoneNumber(client, owner, repo, title)
+		if err != nil {
+			return err
+		}
+		if err := client.SetMilestone(owner, repo, pr.Number, number); err != nil {
+			return wrapGitHubError(err)
+		}
+		ctx.Renderer.Success(fmt.Sprintf("attached milestone: %s", title))
+	}
+
+	if raw := flags.Value["assignee"]; raw != "" {
+		users, err := splitUsernames(raw)
+		if err != nil {
+			return err
+		}
+		if _, err := client.AddAssignees(owner, repo, pr.Number, users); err != nil {
+			return wrapGitHubError(err)
+		}
+		ctx.Renderer.Success(fmt.Sprintf("assigned: %s", strings.Join(users, ", ")))
+	}
+
+	if raw := flags.Value["reviewer"]; raw != "" {
+		users, err := splitUsernames(raw)
+		if err != nil {
+			return err
+		}
+		if err := client.RequestReviewers(owner, repo, pr.Number, users); err != nil {
+			return wrapGitHubError(err)
+		}
+		ctx.Renderer.Success(fmt.Sprintf("requested review from: %s", strings.Join(users, ", ")))
+	}
+	return nil
+}
+
+// splitUsernames splits a comma-separated usernames flag, rejecting any
+// blank entry.
+func splitUsernames(raw string) ([]string, error) {
+	var users []string
+	for _, u := range strings.Split(raw, ",") {
+		u = strings.TrimSpace(u)
+		if u == "" {
+			return nil, fmt.Errorf("usernames must not be empty")
+		}
+		users = append(users, u)
+	}
+	return users, nil
+}
+
+// derivePRTitleAndBody picks a default title and body from the commits a PR
+// would introduce, mirroring `git commit`'s own subject/body split: a single
+// commit's subject and body are used directly, while multiple commits fall
+// back to the branch name as the title and a bulleted list of subjects as
+// the body. It returns empty strings if there are no commits to derive from.
+func derivePRTitleAndBody(commits []git.CommitDetail, branch string) (title, body string) {
+	if len(commits) == 0 {
+		return "", ""
+	}
+	if len(commits) == 1 {
+		return commits[0].Subject, commits[0].Body
+	}
+	lines := make([]string, len(commits))
+	for i, c := range commits {
+		lines[i] = "- " + c.Subject
+	}
+	return branch, strings.Join(lines, "\n")
+}
@@ -0,0 +1,104 @@
+package command
+
+import (
+	"errors"
+	"os"
+	"os/exec"
+	"testing"
+
+	"github.com/buchorim/arngit/internal/core"
+	"github.com/buchorim/arngit/internal/git"
+)
+
+func TestDerivePRTitleAndBodySingleCommit(t *testing.T) {
+	title, body := derivePRTitleAndBody([]git.CommitDetail{
+		{Subject: "add widget support", Body: "Fixes the flux capacitor overload."},
+	}, "add-widget-support")
+	if title != "add widget support" {
+		t.Errorf("title = %q", title)
+	}
+	if body != "Fixes the flux capacitor overload." {
+		t.Errorf("body = %q", body)
+	}
+}
+
+func TestDerivePRTitleAndBodyMultipleCommits(t *testing.T) {
+	title, body := derivePRTitleAndBody([]git.CommitDetail{
+		{Subject: "add widget"},
+		{Subject: "fix widget tests"},
+	}, "widgets")
+	if title != "widgets" {
+		t.Errorf("title = %q, want branch name", title)
+	}
+	want := "- add widget\n- fix widget tests"
+	if body != want {
+		t.Errorf("body = %q, want %q", body, want)
+	}
+}
+
+func TestDerivePRTitleAndBodyNoCommits(t *testing.T) {
+	title, body := derivePRTitleAndBody(nil, "widgets")
+	if title != "" || body != "" {
+		t.Errorf("title = %q, body = %q, want both empty", title, body)
+	}
+}
+
+func TestSplitUsernames(t *testing.T) {
+	got, err := splitUsernames("alice, bob")
+	if err != nil {
+		t.Fatalf("splitUsernames: %v", err)
+	}
+	want := []string{"alice", "bob"}
+	if len(got) != len(want) || got[0] != want[0] || got[1] != want[1] {
+		t.Fatalf("splitUsernames = %v, want %v", got, want)
+	}
+}
+
+func TestSplitUsernamesRejectsBlankEntry(t *testing.T) {
+	if _, err := splitUsernames("alice,,bob"); err == nil {
+		t.Fatal("expected an error for a blank username")
+	}
+}
+
+func TestPRRequiresAccountDeclinesWithoutAccount(t *testing.T) {
+	if _, err := exec.LookPath("git"); err != nil {
+		t.Skip("git not installed")
+	}
+	dir := t.TempDir()
+	if out, err := exec.Command("git", "init", "-q", dir).CombinedOutput(); err != nil {
+		t.Fatalf("git init: %v: %s", err, out)
+	}
+	chdir(t, dir)
+
+	readEnd, writeEnd, err := os.Pipe()
+	if err != nil {
+		t.Fatalf("Pipe: %v", err)
+	}
+	writeEnd.Close()
+	originalStdin := os.Stdin
+	os.Stdin = readEnd
+	defer func() { os.Stdin = originalStdin }()
+
+	router := NewRouter(&core.Engine{Config: core.DefaultConfig(), Accounts: &core.AccountStore{}}, "test")
+	err = router.Dispatch([]string{"pr", "create"})
+	if !errors.Is(err, core.ErrNoAccount) {
+		t.Errorf("err = %v, want ErrNoAccount", err)
+	}
+}
+
+func TestPRRejectsUnknownSubcommand(t *testing.T) {
+	if _, err := exec.LookPath("git"); err != nil {
+		t.Skip("git not installed")
+	}
+	dir := t.TempDir()
+	if out, err := exec.Command("git", "init", "-q", dir).CombinedOutput(); err != nil {
+		t.Fatalf("git init: %v: %s", err, out)
+	}
+	chdir(t, dir)
+
+	router := NewRouter(&core.Engine{Config: core.DefaultConfig(), Accounts: &core.AccountStore{Active: "work"}}, "test")
+	err := router.Dispatch([]string{"pr", "bogus"})
+	if err == nil {
+		t.Fatal("expected an error for an unknown pr subcommand")
+	}
+}
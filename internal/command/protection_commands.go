@@ -0,0 +1,154 @@
+package command
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/buchorim/arngit/internal/github"
+)
+
+// protectionFlags declares the flags `arngit protection set` accepts.
+var protectionFlags = []FlagSpec{
+	{Name: "reviews", Description: "Required number of approving reviews", TakesValue: true},
+	{Name: "status-checks", Description: "Comma-separated required status check contexts", TakesValue: true},
+	{Name: "strict", Description: "Require branches to be up to date with the base branch before merging"},
+	{Name: "enforce-admins", Description: "Apply required checks to repo admins too"},
+	{Name: "dismiss-stale-reviews", Description: "Dismiss stale reviews when new commits are pushed"},
+}
+
+// registerProtectionCommands wires up `arngit protection`. This manages
+// GitHub's branch protection API and is distinct from the local
+// Config.Protected list that guards branches against `arngit branch delete`.
+func (r *Router) registerProtectionCommands() {
+	r.registerCommand(Command{
+		Name:            "protection",
+		Handler:         handleProtection,
+		RequiresRepo:    true,
+		RequiresAccount: true,
+		Subcommands:     []string{"get", "set", "remove"},
+		Flags:           protectionFlags,
+	})
+}
+
+// handleProtection dispatches `arngit protection <get|set|remove> [branch]`.
+// branch defaults to the current branch when omitted.
+func handleProtection(ctx *Context) error {
+	if len(ctx.Args) == 0 {
+		return fmt.Errorf("usage: arngit protection <get|set|remove> [branch]")
+	}
+	sub := ctx.Args[0]
+	rest := ctx.Args[1:]
+
+	flags := parseFlags(protectionFlags, rest)
+	branch := ""
+	if len(flags.Positional) > 0 {
+		branch = flags.Positional[0]
+	} else if b, err := ctx.Git.CurrentBranch(); err == nil {
+		branch = b
+	} else {
+		branch = ctx.Engine.Config.DefaultBranch
+	}
+
+	owner, repo, err := currentOwnerRepo(ctx)
+	if err != nil {
+		return err
+	}
+	client, err := ctx.Engine.GitHubClient()
+	if err != nil {
+		return err
+	}
+
+	switch sub {
+	case "get":
+		return protectionGet(ctx, client, owner, repo, branch)
+	case "set":
+		return protectionSet(ctx, client, owner, repo, branch, flags)
+	case "remove":
+		return protectionRemove(ctx, client, owner, repo, branch)
+	default:
+		return fmt.Errorf("unknown protection subcommand: %s", sub)
+	}
+}
+
+func protectionGet(ctx *Context, client *github.Client, owner, repo, branch string) error {
+	protection, err := client.GetBranchProtection(owner, repo, branch)
+	if err != nil {
+		return wrapGitHubError(err)
+	}
+
+	ctx.Renderer.Plain(fmt.Sprintf("enforce admins:    %t", protection.EnforceAdmins.Enabled))
+	if protection.RequiredPullRequestReviews != nil {
+		ctx.Renderer.Plain(fmt.Sprintf("required reviews:  %d", protection.RequiredPullRequestReviews.RequiredApprovingReviewCount))
+		ctx.Renderer.Plain(fmt.Sprintf("dismiss stale:     %t", protection.RequiredPullRequestReviews.DismissStaleReviews))
+	} else {
+		ctx.Renderer.Plain("required reviews:  none")
+	}
+	if protection.RequiredStatusChecks != nil {
+		ctx.Renderer.Plain(fmt.Sprintf("status checks:     %s", strings.Join(protection.RequiredStatusChecks.Contexts, ", ")))
+		ctx.Renderer.Plain(fmt.Sprintf("strict:            %t", protection.RequiredStatusChecks.Strict))
+	} else {
+		ctx.Renderer.Plain("status checks:     none")
+	}
+	return nil
+}
+
+// buildProtectionUpdate translates parsed `protection set` flags into the
+// PUT body UpdateBranchProtection sends.
+func buildProtectionUpdate(flags ParsedFlags) (github.BranchProtectionUpdate, error) {
+	update := github.BranchProtectionUpdate{EnforceAdmins: flags.Bool["enforce-admins"]}
+
+	if raw := flags.Value["reviews"]; raw != "" {
+		n, err := strconv.Atoi(raw)
+		if err != nil {
+			return github.BranchProtectionUpdate{}, fmt.Errorf("invalid --reviews: %w", err)
+		}
+		update.RequiredPullRequestReviews = &github.RequiredPullRequestReviews{
+			RequiredApprovingReviewCount: n,
+			DismissStaleReviews:          flags.Bool["dismiss-stale-reviews"],
+		}
+	}
+
+	if raw := flags.Value["status-checks"]; raw != "" {
+		update.RequiredStatusChecks = &github.RequiredStatusChecks{
+			Strict:   flags.Bool["strict"],
+			Contexts: strings.Split(raw, ","),
+		}
+	}
+
+	return update, nil
+}
+
+func protectionSet(ctx *Context, client *github.Client, owner, repo, branch string, flags ParsedFlags) error {
+	update, err := buildProtectionUpdate(flags)
+	if err != nil {
+		return err
+	}
+
+	if ctx.DryRun {
+		ctx.Renderer.Info(fmt.Sprintf("dry run: would update branch protection for %s/%s@%s", owner, repo, branch))
+		return nil
+	}
+
+	if _, err := client.UpdateBranchProtection(owner, repo, branch, update); err != nil {
+		return wrapGitHubError(err)
+	}
+	ctx.Renderer.Success(fmt.Sprintf("updated branch protection for %s", branch))
+	return nil
+}
+
+func protectionRemove(ctx *Context, client *github.Client, owner, repo, branch string) error {
+	if !ctx.Confirm(fmt.Sprintf("remove all branch protection from %s? this cannot be undone from here", branch)) {
+		return errors.New("protection removal aborted")
+	}
+	if ctx.DryRun {
+		ctx.Renderer.Info(fmt.Sprintf("dry run: would remove branch protection for %s/%s@%s", owner, repo, branch))
+		return nil
+	}
+	if err := client.RemoveBranchProtection(owner, repo, branch); err != nil {
+		return wrapGitHubError(err)
+	}
+	ctx.Renderer.Success(fmt.Sprintf("removed branch protection from %s", branch))
+	return nil
+}
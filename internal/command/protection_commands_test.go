@@ -0,0 +1,71 @@
+package command
+
+import (
+	"errors"
+	"os"
+	"os/exec"
+	"testing"
+
+	"github.com/buchorim/arngit/internal/core"
+)
+
+func TestBuildProtectionUpdateFromFlags(t *testing.T) {
+	flags := parseFlags(protectionFlags, []string{"--reviews=2", "--status-checks=ci,lint", "--strict", "--enforce-admins", "--dismiss-stale-reviews"})
+
+	update, err := buildProtectionUpdate(flags)
+	if err != nil {
+		t.Fatalf("buildProtectionUpdate: %v", err)
+	}
+	if !update.EnforceAdmins {
+		t.Error("expected EnforceAdmins = true")
+	}
+	if update.RequiredPullRequestReviews == nil || update.RequiredPullRequestReviews.RequiredApprovingReviewCount != 2 || !update.RequiredPullRequestReviews.DismissStaleReviews {
+		t.Errorf("RequiredPullRequestReviews = %+v", update.RequiredPullRequestReviews)
+	}
+	if update.RequiredStatusChecks == nil || !update.RequiredStatusChecks.Strict || len(update.RequiredStatusChecks.Contexts) != 2 {
+		t.Errorf("RequiredStatusChecks = %+v", update.RequiredStatusChecks)
+	}
+}
+
+func TestBuildProtectionUpdateRejectsInvalidReviewCount(t *testing.T) {
+	flags := parseFlags(protectionFlags, []string{"--reviews=not-a-number"})
+	if _, err := buildProtectionUpdate(flags); err == nil {
+		t.Fatal("expected an error for a non-numeric --reviews")
+	}
+}
+
+func TestBuildProtectionUpdateDefaultsToNoRequirements(t *testing.T) {
+	update, err := buildProtectionUpdate(parseFlags(protectionFlags, nil))
+	if err != nil {
+		t.Fatalf("buildProtectionUpdate: %v", err)
+	}
+	if update.RequiredPullRequestReviews != nil || update.RequiredStatusChecks != nil || update.EnforceAdmins {
+		t.Errorf("expected an empty update, got %+v", update)
+	}
+}
+
+func TestProtectionRequiresAccountDeclinesWithoutAccount(t *testing.T) {
+	if _, err := exec.LookPath("git"); err != nil {
+		t.Skip("git not installed")
+	}
+	dir := t.TempDir()
+	if out, err := exec.Command("git", "init", "-q", dir).CombinedOutput(); err != nil {
+		t.Fatalf("git init: %v: %s", err, out)
+	}
+	chdir(t, dir)
+
+	readEnd, writeEnd, err := os.Pipe()
+	if err != nil {
+		t.Fatalf("Pipe: %v", err)
+	}
+	writeEnd.Close()
+	originalStdin := os.Stdin
+	os.Stdin = readEnd
+	defer func() { os.Stdin = originalStdin }()
+
+	router := NewRouter(&core.Engine{Config: core.DefaultConfig(), Accounts: &core.AccountStore{}}, "test")
+	err = router.Dispatch([]string{"protection", "get", "main"})
+	if !errors.Is(err, core.ErrNoAccount) {
+		t.Errorf("err = %v, want ErrNoAccount", err)
+	}
+}
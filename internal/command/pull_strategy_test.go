@@ -0,0 +1,72 @@
+package command
+
+import (
+	"testing"
+
+	"github.com/buchorim/arngit/internal/core"
+	"github.com/buchorim/arngit/internal/git"
+)
+
+func TestPullOptionsForFlagOverridesConfig(t *testing.T) {
+	tests := []struct {
+		name     string
+		args     []string
+		strategy string
+		want     git.PullOptions
+		wantErr  bool
+	}{
+		{"rebase flag wins over merge config", []string{"--rebase"}, "merge", git.PullOptions{Rebase: true}, false},
+		{"ff-only flag wins over rebase config", []string{"--ff-only"}, "rebase", git.PullOptions{FFOnly: true}, false},
+		{"empty config falls back to a plain pull", nil, "", git.PullOptions{}, false},
+		{"merge config is a plain pull", nil, "merge", git.PullOptions{}, false},
+		{"rebase config", nil, "rebase", git.PullOptions{Rebase: true}, false},
+		{"ff-only config", nil, "ff-only", git.PullOptions{FFOnly: true}, false},
+		{"unknown config", nil, "squash", git.PullOptions{}, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			flags := parseFlags(pullFlags, tt.args)
+			got, err := pullOptionsFor(flags, tt.strategy)
+			if tt.wantErr {
+				if err == nil {
+					t.Fatalf("pullOptionsFor(%v, %q): expected an error", tt.args, tt.strategy)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("pullOptionsFor(%v, %q): %v", tt.args, tt.strategy, err)
+			}
+			if got != tt.want {
+				t.Errorf("pullOptionsFor(%v, %q) = %+v, want %+v", tt.args, tt.strategy, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestDescribePull(t *testing.T) {
+	tests := []struct {
+		opts git.PullOptions
+		want string
+	}{
+		{git.PullOptions{}, "git pull"},
+		{git.PullOptions{Rebase: true}, "git pull --rebase"},
+		{git.PullOptions{FFOnly: true}, "git pull --ff-only"},
+	}
+
+	for _, tt := range tests {
+		if got := describePull(tt.opts); got != tt.want {
+			t.Errorf("describePull(%+v) = %q, want %q", tt.opts, got, tt.want)
+		}
+	}
+}
+
+func TestHandlePullRejectsRebaseAndFFOnlyTogether(t *testing.T) {
+	dir := setupPushTestRepo(t)
+	chdir(t, dir)
+
+	router := NewRouter(&core.Engine{Config: core.DefaultConfig()}, "test")
+	if err := router.Dispatch([]string{"pull", "--rebase", "--ff-only"}); err == nil {
+		t.Fatal("expected an error combining --rebase and --ff-only")
+	}
+}
@@ -0,0 +1,108 @@
+package command
+
+import (
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/buchorim/arngit/internal/core"
+	"github.com/buchorim/arngit/internal/git"
+)
+
+func TestPullSummaryUpToDate(t *testing.T) {
+	if _, err := exec.LookPath("git"); err != nil {
+		t.Skip("git not installed")
+	}
+	svc := git.NewService(t.TempDir())
+
+	if got, want := pullSummary(svc, "", "abc123"), "already up to date"; got != want {
+		t.Errorf("pullSummary with no prior HEAD = %q, want %q", got, want)
+	}
+	if got, want := pullSummary(svc, "abc123", "abc123"), "already up to date"; got != want {
+		t.Errorf("pullSummary with unchanged HEAD = %q, want %q", got, want)
+	}
+}
+
+// setupPullTestRepos creates a bare "origin" and a clone of it in separate
+// temp dirs, returning the clone's path.
+func setupPullTestRepos(t *testing.T) string {
+	t.Helper()
+	if _, err := exec.LookPath("git"); err != nil {
+		t.Skip("git not installed")
+	}
+
+	originDir := t.TempDir()
+	run := func(dir string, args ...string) string {
+		cmd := exec.Command("git", args...)
+		cmd.Dir = dir
+		out, err := cmd.CombinedOutput()
+		if err != nil {
+			t.Fatalf("git %v (in %s): %v: %s", args, dir, err, out)
+		}
+		return string(out)
+	}
+	run(originDir, "init", "-q", "-b", "main")
+	run(originDir, "config", "user.email", "test@example.com")
+	run(originDir, "config", "user.name", "test")
+	if err := os.WriteFile(filepath.Join(originDir, "f.txt"), []byte("hi"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	run(originDir, "add", ".")
+	run(originDir, "commit", "-q", "-m", "init")
+
+	cloneDir := filepath.Join(t.TempDir(), "clone")
+	cmd := exec.Command("git", "clone", "-q", originDir, cloneDir)
+	if out, err := cmd.CombinedOutput(); err != nil {
+		t.Fatalf("git clone: %v: %s", err, out)
+	}
+	run(cloneDir, "config", "user.email", "test@example.com")
+	run(cloneDir, "config", "user.name", "test")
+
+	// Give the clone a second, later commit on origin to pull.
+	if err := os.WriteFile(filepath.Join(originDir, "g.txt"), []byte("bye"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	run(originDir, "add", ".")
+	run(originDir, "commit", "-q", "-m", "second")
+
+	return cloneDir
+}
+
+func TestHandlePullReportsCommitCount(t *testing.T) {
+	dir := setupPullTestRepos(t)
+	chdir(t, dir)
+
+	router := NewRouter(&core.Engine{Config: core.DefaultConfig()}, "test")
+	out := captureStdout(t, func() {
+		if err := router.Dispatch([]string{"pull"}); err != nil {
+			t.Fatalf("pull: %v", err)
+		}
+	})
+
+	if !strings.Contains(out, "pulled 1 commit") {
+		t.Errorf("output = %q, want it to mention pulling 1 commit", out)
+	}
+}
+
+func TestHandlePullReportsUpToDateOnNoop(t *testing.T) {
+	dir := setupPullTestRepos(t)
+	chdir(t, dir)
+
+	router := NewRouter(&core.Engine{Config: core.DefaultConfig()}, "test")
+	// Consume the pending commit first so the second pull is a genuine no-op.
+	if err := router.Dispatch([]string{"pull"}); err != nil {
+		t.Fatalf("first pull: %v", err)
+	}
+
+	out := captureStdout(t, func() {
+		if err := router.Dispatch([]string{"pull"}); err != nil {
+			t.Fatalf("second pull: %v", err)
+		}
+	})
+
+	if !strings.Contains(out, "already up to date") {
+		t.Errorf("output = %q, want it to report already up to date", out)
+	}
+}
@@ -0,0 +1,97 @@
+package command
+
+import (
+	"os"
+	"os/exec"
+	"path/filepath"
+	"testing"
+
+	"github.com/buchorim/arngit/internal/core"
+	"github.com/buchorim/arngit/internal/git"
+)
+
+func TestDescribePushAllAndMirror(t *testing.T) {
+	tests := []struct {
+		name   string
+		opts   git.PushOptions
+		branch string
+		want   string
+	}{
+		{"all", git.PushOptions{All: true}, "main", "git push --all origin"},
+		{"mirror", git.PushOptions{Mirror: true}, "main", "git push --mirror origin"},
+		{"mirror takes precedence over all", git.PushOptions{All: true, Mirror: true}, "", "git push --mirror origin"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := describePush("origin", tt.branch, tt.opts); got != tt.want {
+				t.Errorf("describePush = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func setupPushTestRepo(t *testing.T) string {
+	t.Helper()
+	if _, err := exec.LookPath("git"); err != nil {
+		t.Skip("git not installed")
+	}
+
+	dir := t.TempDir()
+	run := func(args ...string) {
+		cmd := exec.Command("git", args...)
+		cmd.Dir = dir
+		if out, err := cmd.CombinedOutput(); err != nil {
+			t.Fatalf("git %v: %v: %s", args, err, out)
+		}
+	}
+	run("init", "-q", "-b", "main")
+	run("config", "user.email", "test@example.com")
+	run("config", "user.name", "test")
+	if err := os.WriteFile(filepath.Join(dir, "f.txt"), []byte("hi"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	run("add", ".")
+	run("commit", "-q", "-m", "init")
+
+	return dir
+}
+
+func TestHandlePushMirrorRequiresConfirmation(t *testing.T) {
+	dir := setupPushTestRepo(t)
+	chdir(t, dir)
+
+	readEnd, writeEnd, err := os.Pipe()
+	if err != nil {
+		t.Fatalf("Pipe: %v", err)
+	}
+	writeEnd.Close() // simulate EOF/decline
+	originalStdin := os.Stdin
+	os.Stdin = readEnd
+	defer func() { os.Stdin = originalStdin }()
+
+	router := NewRouter(&core.Engine{Config: core.DefaultConfig()}, "test")
+	if err := router.Dispatch([]string{"push", "--mirror", "--dry-run"}); err == nil {
+		t.Fatal("expected push --mirror to be aborted without confirmation")
+	}
+}
+
+func TestHandlePushMirrorProceedsWithYes(t *testing.T) {
+	dir := setupPushTestRepo(t)
+	chdir(t, dir)
+
+	router := NewRouter(&core.Engine{Config: core.DefaultConfig()}, "test")
+	if err := router.Dispatch([]string{"push", "--mirror", "--yes", "--dry-run"}); err != nil {
+		t.Fatalf("push --mirror --yes --dry-run: %v", err)
+	}
+}
+
+func TestHandlePushRejectsAllAndMirrorTogether(t *testing.T) {
+	dir := setupPushTestRepo(t)
+	chdir(t, dir)
+
+	router := NewRouter(&core.Engine{Config: core.DefaultConfig()}, "test")
+	if err := router.Dispatch([]string{"push", "--all", "--mirror", "--yes"}); err == nil {
+		t.Fatal("expected an error combining --all and --mirror")
+	}
+}
@@ -0,0 +1,45 @@
+package command
+
+import "fmt"
+
+var rebaseFlags = []FlagSpec{
+	{Name: "autosquash", Description: "Fold --fixup/--squash commits into their targets"},
+}
+
+// registerRebaseCommands wires up `arngit rebase`.
+func (r *Router) registerRebaseCommands() {
+	r.registerCommand(Command{
+		Name:         "rebase",
+		Handler:      handleRebase,
+		RequiresRepo: true,
+		Flags:        rebaseFlags,
+	})
+}
+
+// handleRebase implements `arngit rebase --autosquash <onto>`.
+func handleRebase(ctx *Context) error {
+	flags := parseFlags(rebaseFlags, ctx.Args)
+
+	if !flags.Bool["autosquash"] {
+		return fmt.Errorf("usage: arngit rebase --autosquash <onto>")
+	}
+	if len(flags.Positional) == 0 {
+		return fmt.Errorf("usage: arngit rebase --autosquash <onto>")
+	}
+	onto := flags.Positional[0]
+
+	if ctx.DryRun {
+		ctx.Renderer.Info(fmt.Sprintf("dry run: would run git rebase -i --autosquash %s", onto))
+		return nil
+	}
+
+	out, err := ctx.Git.RebaseAutosquash(onto)
+	if err != nil {
+		return err
+	}
+	ctx.Renderer.Success("rebased")
+	if out != "" {
+		ctx.Renderer.Plain(out)
+	}
+	return nil
+}
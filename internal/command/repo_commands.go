@@ -0,0 +1,140 @@
+package command
+
+import (
+	"errors"
+	"fmt"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/buchorim/arngit/internal/core"
+	"github.com/buchorim/arngit/internal/git"
+	"github.com/buchorim/arngit/internal/github"
+)
+
+// registerRepoCommands wires up `arngit repo` and `arngit init`.
+func (r *Router) registerRepoCommands() {
+	r.registerAccountRequiredCommand("repo", handleRepo)
+	r.registerCommand(Command{Name: "init", Handler: handleInit})
+}
+
+// handleInit implements `arngit init`, creating a repository in the current
+// directory whose initial branch is Config.DefaultBranch rather than
+// whatever git itself defaults to.
+func handleInit(ctx *Context) error {
+	if ctx.Git.IsRepo() {
+		return fmt.Errorf("already a git repository")
+	}
+
+	branch := ctx.Engine.Config.DefaultBranch
+
+	if ctx.DryRun {
+		ctx.Renderer.Info(fmt.Sprintf("dry run: would run git init -b %s", branch))
+		return nil
+	}
+
+	out, err := ctx.Git.Init(branch)
+	if err != nil {
+		return fmt.Errorf("git init: %w", err)
+	}
+	if out != "" {
+		ctx.Renderer.Info(out)
+	}
+	ctx.Renderer.Success(fmt.Sprintf("initialized repository on branch %q", branch))
+	return nil
+}
+
+// handleRepo dispatches `arngit repo <subcommand>`.
+func handleRepo(ctx *Context) error {
+	if len(ctx.Args) == 0 {
+		return fmt.Errorf("usage: arngit repo <clone-all> [args]")
+	}
+
+	switch ctx.Args[0] {
+	case "clone-all":
+		return repoCloneAll(ctx, ctx.Args[1:])
+	default:
+		return fmt.Errorf("unknown repo subcommand: %s", ctx.Args[0])
+	}
+}
+
+// repoCloneAll implements `arngit repo clone-all <dir> [--org X] [--concurrency N]`,
+// cloning every repo in the org into dir in parallel.
+func repoCloneAll(ctx *Context, args []string) error {
+	var (
+		targetDir   string
+		org         string
+		concurrency = 4
+	)
+
+	for _, a := range args {
+		switch {
+		case strings.HasPrefix(a, "--org="):
+			org = strings.TrimPrefix(a, "--org=")
+		case strings.HasPrefix(a, "--concurrency="):
+			n, err := strconv.Atoi(strings.TrimPrefix(a, "--concurrency="))
+			if err != nil {
+				return fmt.Errorf("invalid --concurrency: %w", err)
+			}
+			concurrency = n
+		case strings.HasPrefix(a, "--"):
+			return fmt.Errorf("unknown clone-all flag: %s", a)
+		case targetDir == "":
+			targetDir = a
+		default:
+			return fmt.Errorf("unexpected argument: %s", a)
+		}
+	}
+
+	if targetDir == "" {
+		return fmt.Errorf("usage: arngit repo clone-all <dir> --org X [--concurrency N]")
+	}
+	if org == "" {
+		return fmt.Errorf("--org is required")
+	}
+
+	client, err := ctx.Engine.GitHubClient()
+	if err != nil {
+		return err
+	}
+
+	repos, err := client.ListOrgRepos(org)
+	if err != nil {
+		var apiErr *github.APIError
+		if errors.As(err, &apiErr) {
+			return core.FromAPIError(apiErr)
+		}
+		return fmt.Errorf("listing repos for %s: %w", org, err)
+	}
+
+	networkTimeout, _ := time.ParseDuration(ctx.Engine.Config.NetworkTimeout)
+	cloneOpts := git.CloneOptions{Timeout: networkTimeout, ProxyURL: ctx.Engine.Config.HTTPProxy}
+
+	tasks := make([]func() error, len(repos))
+	for i, repo := range repos {
+		repo := repo
+		tasks[i] = func() error {
+			_, err := git.Clone(repo.CloneURL, filepath.Join(targetDir, repo.Name), cloneOpts)
+			return err
+		}
+	}
+
+	pool := core.NewPool(concurrency)
+	errs := pool.Run(tasks)
+
+	failed := 0
+	for i, err := range errs {
+		if err == nil {
+			ctx.Renderer.Success(repos[i].Name)
+			continue
+		}
+		failed++
+		ctx.Renderer.Error(fmt.Sprintf("%s: %v", repos[i].Name, err))
+	}
+
+	if failed > 0 {
+		return fmt.Errorf("%d of %d repos failed to clone", failed, len(repos))
+	}
+	return nil
+}
@@ -0,0 +1,287 @@
+// Package command dispatches parsed CLI arguments to their handlers.
+package command
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"os/signal"
+	"strings"
+	"time"
+
+	"github.com/buchorim/arngit/internal/core"
+	"github.com/buchorim/arngit/internal/git"
+	"github.com/buchorim/arngit/internal/ui"
+)
+
+// Context bundles the state a command handler needs: the engine (config,
+// accounts, protection), a renderer for output, and a git service rooted at
+// the current working directory.
+type Context struct {
+	Engine   *core.Engine
+	Renderer *ui.Renderer
+	Git      *git.Service
+	Args     []string
+	Version  string
+
+	// DryRun, when set (via the global --dry-run flag), tells destructive
+	// or network-touching handlers to report what they would do instead
+	// of doing it.
+	DryRun bool
+
+	// router is the Router that dispatched this command, used by commands
+	// that introspect the registered command tree (e.g. `completion`).
+	router *Router
+}
+
+// Confirm prompts the user on stdin/stdout, honoring an already-passed
+// --yes flag by skipping the prompt entirely.
+func (ctx *Context) Confirm(prompt string) bool {
+	if hasFlag(ctx.Args, "--yes") || hasFlag(ctx.Args, "-y") {
+		return true
+	}
+	return ui.Confirm(os.Stdin, os.Stdout, prompt)
+}
+
+// Handler runs a single command given its remaining arguments.
+type Handler func(ctx *Context) error
+
+// Command is a registered handler plus the preconditions Dispatch enforces
+// before running it.
+type Command struct {
+	Name    string
+	Handler Handler
+
+	// RequiresRepo, when set, makes Dispatch fail fast with ErrGitNoRepo
+	// (and its hint) if the current directory isn't a git repository,
+	// instead of letting the handler discover that itself.
+	RequiresRepo bool
+
+	// RequiresAccount, when set, makes Dispatch check for a configured
+	// GitHub account up front. If none is active, it offers to run
+	// `account add` interactively instead of failing deep inside a
+	// GitHub API call with a generic error.
+	RequiresAccount bool
+
+	// Subcommands lists this command's first-level subcommand names, if
+	// any (e.g. "account" -> "add", "list", "use"). It's informational
+	// only, used to drive interactive-mode tab completion; handlers still
+	// validate ctx.Args themselves.
+	Subcommands []string
+
+	// Flags describes the flags this command accepts, used to render its
+	// FLAGS section in `help` and to reject unrecognized flags in
+	// Dispatch. Commands with ad-hoc or no flags leave this empty.
+	Flags []FlagSpec
+}
+
+// FlagSpec describes one flag a command accepts: its long name (without
+// "--"), an optional single-character shorthand (without "-"), and a short
+// human-readable description. TakesValue distinguishes a boolean switch
+// (e.g. --force) from a "--name=value" flag (e.g. --timeout=30s).
+type FlagSpec struct {
+	Name        string
+	Shorthand   string
+	Description string
+	TakesValue  bool
+}
+
+// Router maps command names to handlers.
+type Router struct {
+	handlers map[string]Command
+	engine   *core.Engine
+	version  string
+}
+
+// NewRouter builds a Router with all built-in commands registered.
+func NewRouter(engine *core.Engine, version string) *Router {
+	r := &Router{
+		handlers: make(map[string]Command),
+		engine:   engine,
+		version:  version,
+	}
+	r.registerGitCommands()
+	r.registerAccountCommands()
+	r.registerSystemCommands()
+	r.registerLogsCommands()
+	r.registerDoctorCommands()
+	r.registerStorageCommands()
+	r.registerUpdateCommands()
+	r.registerRepoCommands()
+	r.registerSyncAllCommands()
+	r.registerOpenCommands()
+	r.registerContributorsCommands()
+	r.registerStatsCommands()
+	r.registerChurnCommands()
+	r.registerLargestFilesCommands()
+	r.registerCompletionCommands()
+	r.registerVersionCommands()
+	r.registerHelpCommands()
+	r.registerBranchCommands()
+	r.registerPatchCommands()
+	r.registerBisectCommands()
+	r.registerArchiveCommands()
+	r.registerDescribeCommands()
+	r.registerShortlogCommands()
+	r.registerRebaseCommands()
+	r.registerScanCommands()
+	r.registerIgnoreCommands()
+	r.registerSyncCommands()
+	r.registerUndoCommands()
+	r.registerJournalCommands()
+	r.registerProtectionCommands()
+	r.registerWebhookCommands()
+	r.registerDeployKeyCommands()
+	r.registerActionsCommands()
+	r.registerGistCommands()
+	r.registerStarCommands()
+	r.registerForkCommands()
+	r.registerInboxCommands()
+	r.registerCompareCommands()
+	r.registerPRCommands()
+	r.registerLabelCommands()
+	r.registerMilestoneCommands()
+	return r
+}
+
+// register adds a handler under name that doesn't require a git repository,
+// overwriting any existing one.
+func (r *Router) register(name string, h Handler) {
+	r.registerCommand(Command{Name: name, Handler: h})
+}
+
+// registerRepoCommand is register, but marks the command as requiring a git
+// repository (see Command.RequiresRepo).
+func (r *Router) registerRepoCommand(name string, h Handler) {
+	r.registerCommand(Command{Name: name, Handler: h, RequiresRepo: true})
+}
+
+// registerAccountRequiredCommand is register, but marks the command as
+// requiring a configured GitHub account (see Command.RequiresAccount).
+func (r *Router) registerAccountRequiredCommand(name string, h Handler) {
+	r.registerCommand(Command{Name: name, Handler: h, RequiresAccount: true})
+}
+
+// registerCommand adds cmd under cmd.Name, overwriting any existing one.
+func (r *Router) registerCommand(cmd Command) {
+	r.handlers[cmd.Name] = cmd
+}
+
+// globalFlags are flags recognized ahead of the command name, wherever they
+// appear in the argument list.
+type globalFlags struct {
+	color   *bool
+	verbose bool
+	timeout time.Duration
+	dryRun  bool
+}
+
+// Dispatch looks up args[0] as a command name and runs it with the rest of
+// args. An unknown command returns an error. Global flags are stripped out
+// of args wherever they appear before dispatch.
+func (r *Router) Dispatch(args []string) error {
+	flags, args := extractGlobalFlags(args)
+
+	if len(args) == 0 {
+		return fmt.Errorf("no command given")
+	}
+
+	cmd, ok := r.handlers[args[0]]
+	if !ok {
+		if suggestion := r.suggest(args[0]); suggestion != "" {
+			return fmt.Errorf("unknown command: %s (did you mean %q?)", args[0], suggestion)
+		}
+		return fmt.Errorf("unknown command: %s", args[0])
+	}
+
+	if err := validateFlags(cmd, args[1:]); err != nil {
+		return err
+	}
+
+	cwd, err := os.Getwd()
+	if err != nil {
+		return err
+	}
+
+	color := ui.ResolveColor(flags.color, r.engine.Config.ColorOutput, ui.IsTTY(os.Stdout))
+
+	cmdCtx, stop := signal.NotifyContext(context.Background(), os.Interrupt)
+	defer stop()
+
+	gitSvc := git.NewService(cwd)
+	gitSvc.Verbose = flags.verbose
+	gitSvc.Ctx = cmdCtx
+	if d, err := time.ParseDuration(r.engine.Config.GitTimeout); err == nil {
+		gitSvc.Timeout = d
+	}
+	if flags.timeout > 0 {
+		gitSvc.NetworkTimeout = flags.timeout
+	} else if d, err := time.ParseDuration(r.engine.Config.NetworkTimeout); err == nil {
+		gitSvc.NetworkTimeout = d
+	}
+	gitSvc.ProxyURL = r.engine.Config.HTTPProxy
+	r.engine.NetworkTimeout = gitSvc.NetworkTimeout
+
+	if cmd.RequiresRepo && !gitSvc.IsRepo() {
+		return core.NewAppError(core.ErrGitNoRepo)
+	}
+
+	ctx := &Context{
+		Engine:   r.engine,
+		Renderer: ui.NewRenderer(color, r.engine.Config.Theme),
+		Git:      gitSvc,
+		Args:     args[1:],
+		Version:  r.version,
+		DryRun:   flags.dryRun,
+		router:   r,
+	}
+	ctx.Renderer.ASCIIOnly = ui.DetectASCIIOnly(r.engine.Config.ASCIIOnly)
+
+	if cmd.RequiresAccount && r.engine.Accounts.Active == "" {
+		if !ui.Confirm(os.Stdin, os.Stdout, "No GitHub account configured. Add one now?") {
+			return core.NewAppError(core.ErrNoAccount)
+		}
+		if err := accountAdd(&Context{Engine: r.engine, Renderer: ctx.Renderer, Git: gitSvc, Version: r.version}); err != nil {
+			return err
+		}
+	}
+
+	if err := cmd.Handler(ctx); err != nil {
+		return core.FromGitError(err)
+	}
+	return nil
+}
+
+// extractGlobalFlags pulls --color/--no-color/--color=<bool> and
+// --verbose/-v out of args, returning the parsed flags and the remaining
+// command-specific args.
+func extractGlobalFlags(args []string) (globalFlags, []string) {
+	var flags globalFlags
+	rest := args[:0:0]
+
+	for _, a := range args {
+		switch {
+		case a == "--color":
+			enabled := true
+			flags.color = &enabled
+		case a == "--no-color":
+			enabled := false
+			flags.color = &enabled
+		case strings.HasPrefix(a, "--color="):
+			enabled := strings.TrimPrefix(a, "--color=") == "true"
+			flags.color = &enabled
+		case a == "--verbose" || a == "-v":
+			flags.verbose = true
+		case a == "--dry-run":
+			flags.dryRun = true
+		case strings.HasPrefix(a, "--timeout="):
+			if d, err := time.ParseDuration(strings.TrimPrefix(a, "--timeout=")); err == nil {
+				flags.timeout = d
+			}
+		default:
+			rest = append(rest, a)
+		}
+	}
+
+	return flags, rest
+}
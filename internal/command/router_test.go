@@ -0,0 +1,111 @@
+package command
+
+import (
+	"errors"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"testing"
+
+	"github.com/buchorim/arngit/internal/core"
+)
+
+func TestExtractGlobalFlags(t *testing.T) {
+	flags, rest := extractGlobalFlags([]string{"status", "--verbose", "-v"})
+	if !flags.verbose {
+		t.Fatal("expected verbose flag to be set")
+	}
+	if len(rest) != 1 || rest[0] != "status" {
+		t.Fatalf("rest = %v, want [status]", rest)
+	}
+}
+
+func chdir(t *testing.T, dir string) {
+	t.Helper()
+	original, err := os.Getwd()
+	if err != nil {
+		t.Fatalf("Getwd: %v", err)
+	}
+	if err := os.Chdir(dir); err != nil {
+		t.Fatalf("Chdir: %v", err)
+	}
+	t.Cleanup(func() { os.Chdir(original) })
+}
+
+func TestDispatchRequiresRepoFailsOutsideRepo(t *testing.T) {
+	chdir(t, t.TempDir())
+
+	router := NewRouter(&core.Engine{Config: core.DefaultConfig()}, "test")
+	err := router.Dispatch([]string{"status"})
+	if err == nil {
+		t.Fatal("expected an error outside a git repository")
+	}
+	if core.GetErrorHint(err) == "" {
+		t.Error("expected the not-a-repo error to carry a hint")
+	}
+}
+
+func TestDispatchRequiresRepoSucceedsInsideRepo(t *testing.T) {
+	if _, err := exec.LookPath("git"); err != nil {
+		t.Skip("git not installed")
+	}
+
+	dir := t.TempDir()
+	run := func(args ...string) {
+		cmd := exec.Command("git", args...)
+		cmd.Dir = dir
+		if out, err := cmd.CombinedOutput(); err != nil {
+			t.Fatalf("git %v: %v: %s", args, err, out)
+		}
+	}
+	run("init", "-q")
+	run("config", "user.email", "test@example.com")
+	run("config", "user.name", "test")
+	if err := os.WriteFile(filepath.Join(dir, "f.txt"), []byte("hi"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	run("add", ".")
+	run("commit", "-q", "-m", "init")
+
+	chdir(t, dir)
+
+	router := NewRouter(&core.Engine{Config: core.DefaultConfig()}, "test")
+	if err := router.Dispatch([]string{"status"}); err != nil {
+		t.Fatalf("Dispatch: %v", err)
+	}
+}
+
+func TestDispatchRequiresAccountDeclinesWithoutAccount(t *testing.T) {
+	readEnd, writeEnd, err := os.Pipe()
+	if err != nil {
+		t.Fatalf("Pipe: %v", err)
+	}
+	writeEnd.Close()
+	originalStdin := os.Stdin
+	os.Stdin = readEnd
+	defer func() { os.Stdin = originalStdin }()
+
+	router := NewRouter(&core.Engine{Config: core.DefaultConfig(), Accounts: &core.AccountStore{}}, "test")
+	dispatchErr := router.Dispatch([]string{"repo", "clone-all", "dir", "--org=acme"})
+	if !errors.Is(dispatchErr, core.ErrNoAccount) {
+		t.Errorf("err = %v, want ErrNoAccount", dispatchErr)
+	}
+}
+
+func TestDispatchRequiresAccountSkipsPromptWhenActive(t *testing.T) {
+	router := NewRouter(&core.Engine{Config: core.DefaultConfig(), Accounts: &core.AccountStore{Active: "work"}}, "test")
+	err := router.Dispatch([]string{"repo", "clone-all"})
+	if errors.Is(err, core.ErrNoAccount) {
+		t.Fatal("should not prompt for an account when one is already active")
+	}
+}
+
+func TestExtractGlobalFlagsColor(t *testing.T) {
+	flags, rest := extractGlobalFlags([]string{"--no-color", "status"})
+	if flags.color == nil || *flags.color {
+		t.Fatalf("expected color flag false, got %v", flags.color)
+	}
+	if len(rest) != 1 || rest[0] != "status" {
+		t.Fatalf("rest = %v, want [status]", rest)
+	}
+}
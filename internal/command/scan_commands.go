@@ -0,0 +1,75 @@
+package command
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/buchorim/arngit/internal/git"
+	"github.com/buchorim/arngit/internal/logging"
+)
+
+// registerScanCommands wires up the secret scanner as an on-demand command.
+func (r *Router) registerScanCommands() {
+	r.registerRepoCommand("scan", handleScan)
+}
+
+// handleScan implements `arngit scan`: run the same secret scan as commit
+// time against the staged diff, or the working-tree diff if nothing is
+// staged, and report anything found.
+func handleScan(ctx *Context) error {
+	diff, err := ctx.Git.Diff(git.DiffOptions{Staged: true})
+	if err != nil {
+		return err
+	}
+	if strings.TrimSpace(diff) == "" {
+		diff, err = ctx.Git.Diff(git.DiffOptions{})
+		if err != nil {
+			return err
+		}
+	}
+
+	findings := scanSecrets(diff)
+	if len(findings) == 0 {
+		ctx.Renderer.Success("no likely secrets found")
+		return nil
+	}
+
+	for _, f := range findings {
+		ctx.Renderer.Warn(f)
+	}
+	return fmt.Errorf("found %d likely secret(s) in the diff", len(findings))
+}
+
+// scanSecrets checks diff (a unified diff, as produced by `git diff`) for
+// added lines matching logging.SecretPatterns' known token shapes, or
+// otherwise containing a generic high-entropy string (see
+// logging.HighEntropyToken), returning one human-readable finding per match.
+// It only looks at added lines ("+" prefixed, excluding the "+++" file
+// header), since removed secrets aren't being introduced. A line already
+// flagged by a known pattern isn't also entropy-checked, since the matched
+// token itself is usually the only high-entropy run on the line.
+func scanSecrets(diff string) []string {
+	var findings []string
+	for _, line := range strings.Split(diff, "\n") {
+		if !strings.HasPrefix(line, "+") || strings.HasPrefix(line, "+++") {
+			continue
+		}
+		content := line[1:]
+
+		matched := false
+		for _, pattern := range logging.SecretPatterns {
+			if pattern.MatchString(content) {
+				findings = append(findings, fmt.Sprintf("possible secret matching %s: %s", pattern.String(), strings.TrimSpace(content)))
+				matched = true
+			}
+		}
+		if matched {
+			continue
+		}
+
+		if token, ok := logging.HighEntropyToken(content); ok {
+			findings = append(findings, fmt.Sprintf("possible high-entropy secret: %s", strings.TrimSpace(token)))
+		}
+	}
+	return findings
+}
@@ -0,0 +1,41 @@
+package command
+
+import (
+	"fmt"
+
+	"github.com/buchorim/arngit/internal/git"
+)
+
+var shortlogFlags = []FlagSpec{
+	{Name: "since", Description: "Only include commits after this date", TakesValue: true},
+	{Name: "no-merges", Description: "Exclude merge commits"},
+}
+
+// registerShortlogCommands wires up `arngit shortlog`.
+func (r *Router) registerShortlogCommands() {
+	r.registerCommand(Command{
+		Name:         "shortlog",
+		Handler:      handleShortlog,
+		RequiresRepo: true,
+		Flags:        shortlogFlags,
+	})
+}
+
+// handleShortlog prints the per-author commit summary produced by
+// `git shortlog`, grouping each author's subjects underneath their name.
+func handleShortlog(ctx *Context) error {
+	flags := parseFlags(shortlogFlags, ctx.Args)
+
+	out, err := ctx.Git.Shortlog(flags.Value["since"], flags.Bool["no-merges"])
+	if err != nil {
+		return err
+	}
+
+	for _, entry := range git.ParseShortlog(out) {
+		ctx.Renderer.Plain(fmt.Sprintf("%s (%d):", entry.Author, entry.Count))
+		for _, subject := range entry.Subjects {
+			ctx.Renderer.Plain("      " + subject)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,91 @@
+package command
+
+import (
+	"fmt"
+	"strings"
+)
+
+// registerStarCommands wires up `arngit star`, `arngit unstar`, and
+// `arngit stars`.
+func (r *Router) registerStarCommands() {
+	r.registerAccountRequiredCommand("star", handleStar)
+	r.registerAccountRequiredCommand("unstar", handleUnstar)
+	r.registerAccountRequiredCommand("stars", handleStars)
+}
+
+// splitOwnerRepo splits an "owner/repo" argument into its two parts.
+func splitOwnerRepo(arg string) (owner, repo string, err error) {
+	parts := strings.SplitN(arg, "/", 2)
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		return "", "", fmt.Errorf("expected owner/repo, got %q", arg)
+	}
+	return parts[0], parts[1], nil
+}
+
+func handleStar(ctx *Context) error {
+	if len(ctx.Args) != 1 {
+		return fmt.Errorf("usage: arngit star <owner/repo>")
+	}
+	owner, repo, err := splitOwnerRepo(ctx.Args[0])
+	if err != nil {
+		return err
+	}
+	client, err := ctx.Engine.GitHubClient()
+	if err != nil {
+		return err
+	}
+
+	if ctx.DryRun {
+		ctx.Renderer.Info(fmt.Sprintf("dry run: would star %s/%s", owner, repo))
+		return nil
+	}
+	if err := client.StarRepo(owner, repo); err != nil {
+		return wrapGitHubError(err)
+	}
+	ctx.Renderer.Success(fmt.Sprintf("starred %s/%s", owner, repo))
+	return nil
+}
+
+func handleUnstar(ctx *Context) error {
+	if len(ctx.Args) != 1 {
+		return fmt.Errorf("usage: arngit unstar <owner/repo>")
+	}
+	owner, repo, err := splitOwnerRepo(ctx.Args[0])
+	if err != nil {
+		return err
+	}
+	client, err := ctx.Engine.GitHubClient()
+	if err != nil {
+		return err
+	}
+
+	if ctx.DryRun {
+		ctx.Renderer.Info(fmt.Sprintf("dry run: would unstar %s/%s", owner, repo))
+		return nil
+	}
+	if err := client.UnstarRepo(owner, repo); err != nil {
+		return wrapGitHubError(err)
+	}
+	ctx.Renderer.Success(fmt.Sprintf("unstarred %s/%s", owner, repo))
+	return nil
+}
+
+func handleStars(ctx *Context) error {
+	client, err := ctx.Engine.GitHubClient()
+	if err != nil {
+		return err
+	}
+
+	repos, err := client.ListStarred()
+	if err != nil {
+		return wrapGitHubError(err)
+	}
+	if len(repos) == 0 {
+		ctx.Renderer.Plain("no starred repositories")
+		return nil
+	}
+	for _, r := range repos {
+		ctx.Renderer.Plain(fmt.Sprintf("%s  %s", r.FullName, r.HTMLURL))
+	}
+	return nil
+}
@@ -0,0 +1,63 @@
+package command
+
+import (
+	"errors"
+	"os"
+	"testing"
+
+	"github.com/buchorim/arngit/internal/core"
+)
+
+func TestSplitOwnerRepo(t *testing.T) {
+	tests := []struct {
+		name      string
+		arg       string
+		wantOwner string
+		wantRepo  string
+		wantErr   bool
+	}{
+		{"valid", "acme/widgets", "acme", "widgets", false},
+		{"missing slash", "widgets", "", "", true},
+		{"empty owner", "/widgets", "", "", true},
+		{"empty repo", "acme/", "", "", true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			owner, repo, err := splitOwnerRepo(tt.arg)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("splitOwnerRepo(%q) error = %v, wantErr %v", tt.arg, err, tt.wantErr)
+			}
+			if err != nil {
+				return
+			}
+			if owner != tt.wantOwner || repo != tt.wantRepo {
+				t.Errorf("splitOwnerRepo(%q) = %q, %q, want %q, %q", tt.arg, owner, repo, tt.wantOwner, tt.wantRepo)
+			}
+		})
+	}
+}
+
+func TestStarRequiresAccountDeclinesWithoutAccount(t *testing.T) {
+	readEnd, writeEnd, err := os.Pipe()
+	if err != nil {
+		t.Fatalf("Pipe: %v", err)
+	}
+	writeEnd.Close()
+	originalStdin := os.Stdin
+	os.Stdin = readEnd
+	defer func() { os.Stdin = originalStdin }()
+
+	router := NewRouter(&core.Engine{Config: core.DefaultConfig(), Accounts: &core.AccountStore{}}, "test")
+	err = router.Dispatch([]string{"star", "acme/widgets"})
+	if !errors.Is(err, core.ErrNoAccount) {
+		t.Errorf("err = %v, want ErrNoAccount", err)
+	}
+}
+
+func TestStarRejectsMalformedArgument(t *testing.T) {
+	router := NewRouter(&core.Engine{Config: core.DefaultConfig(), Accounts: &core.AccountStore{Active: "work"}}, "test")
+	err := router.Dispatch([]string{"star", "not-owner-slash-repo"})
+	if err == nil {
+		t.Fatal("expected an error for a malformed owner/repo argument")
+	}
+}
@@ -0,0 +1,138 @@
+package command
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/buchorim/arngit/internal/analytics"
+	"github.com/buchorim/arngit/internal/ui"
+)
+
+// registerStatsCommands wires up `arngit stats`.
+func (r *Router) registerStatsCommands() {
+	r.registerRepoCommand("stats", handleStats)
+}
+
+var weekdayLabels = [7]string{"Sun", "Mon", "Tue", "Wed", "Thu", "Fri", "Sat"}
+
+// handleStats implements `arngit stats [--limit N] [--weeks N]`, printing a
+// commit-activity heatmap by weekday and by hour.
+func handleStats(ctx *Context) error {
+	limit := 100
+	weeks := 0
+
+	for _, a := range ctx.Args {
+		switch {
+		case strings.HasPrefix(a, "--limit="):
+			n, err := strconv.Atoi(strings.TrimPrefix(a, "--limit="))
+			if err != nil {
+				return fmt.Errorf("invalid --limit: %w", err)
+			}
+			limit = n
+		case strings.HasPrefix(a, "--weeks="):
+			n, err := strconv.Atoi(strings.TrimPrefix(a, "--weeks="))
+			if err != nil {
+				return fmt.Errorf("invalid --weeks: %w", err)
+			}
+			weeks = n
+		default:
+			return fmt.Errorf("unknown stats flag: %s", a)
+		}
+	}
+
+	var since time.Time
+	if weeks > 0 {
+		since = time.Now().AddDate(0, 0, -7*weeks)
+	}
+
+	stats, err := analytics.GetRepoStats(ctx.Git, limit, since)
+	if err != nil {
+		return err
+	}
+
+	ctx.Renderer.Title(fmt.Sprintf("commit activity (%d commits)", stats.Activity.Total))
+
+	ctx.Renderer.Plain("by author:")
+	authors := sortedAuthorsByCount(stats.Activity.ByAuthor)
+	maxAuthor := 0
+	if len(authors) > 0 {
+		maxAuthor = stats.Activity.ByAuthor[authors[0]]
+	}
+	for _, name := range authors {
+		count := stats.Activity.ByAuthor[name]
+		ctx.Renderer.Plain(fmt.Sprintf("  %-25s %s %d", name, renderBar(ctx.Renderer, count, maxAuthor, 20), count))
+	}
+
+	ctx.Renderer.Plain("by weekday:")
+	for i, label := range weekdayLabels {
+		ctx.Renderer.Plain(fmt.Sprintf("  %-3s %s %d", label, renderBar(ctx.Renderer, stats.Activity.ByWeekday[i], maxInt(stats.Activity.ByWeekday[:]), 20), stats.Activity.ByWeekday[i]))
+	}
+
+	ctx.Renderer.Plain("by hour:")
+	maxHour := maxInt(stats.Activity.ByHour[:])
+	for h := 0; h < 24; h++ {
+		ctx.Renderer.Plain(fmt.Sprintf("  %02d  %s %d", h, renderBar(ctx.Renderer, stats.Activity.ByHour[h], maxHour, 20), stats.Activity.ByHour[h]))
+	}
+
+	breakdown, err := analytics.GetLanguageBreakdown(ctx.Git)
+	if err != nil {
+		return err
+	}
+	ctx.Renderer.Plain("languages:")
+	for _, ext := range sortedByLines(breakdown) {
+		stat := breakdown[ext]
+		ctx.Renderer.Plain(fmt.Sprintf("  %-12s %6d files  %8d lines", ext, stat.Files, stat.Lines))
+	}
+
+	return nil
+}
+
+// sortedAuthorsByCount returns byAuthor's keys ordered by commit count,
+// descending, so the busiest contributor's bar sets the scale.
+func sortedAuthorsByCount(byAuthor map[string]int) []string {
+	names := make([]string, 0, len(byAuthor))
+	for name := range byAuthor {
+		names = append(names, name)
+	}
+	sort.Slice(names, func(i, j int) bool {
+		return byAuthor[names[i]] > byAuthor[names[j]]
+	})
+	return names
+}
+
+// sortedByLines returns breakdown's keys ordered by line count, descending.
+func sortedByLines(breakdown map[string]analytics.LanguageStat) []string {
+	exts := make([]string, 0, len(breakdown))
+	for ext := range breakdown {
+		exts = append(exts, ext)
+	}
+	sort.Slice(exts, func(i, j int) bool {
+		return breakdown[exts[i]].Lines > breakdown[exts[j]].Lines
+	})
+	return exts
+}
+
+// renderBar draws a block bar for count scaled relative to max, width blocks
+// wide at most, using r's symbol set (see Renderer.ASCIIOnly). max <= 0
+// yields an empty bar.
+func renderBar(r *ui.Renderer, count, max, width int) string {
+	if max <= 0 {
+		return r.Bar(0, width)
+	}
+	filled := int(float64(count) / float64(max) * float64(width))
+	return r.Bar(filled, width)
+}
+
+// maxInt returns the largest value in vals, or 0 for an empty slice.
+func maxInt(vals []int) int {
+	max := 0
+	for _, v := range vals {
+		if v > max {
+			max = v
+		}
+	}
+	return max
+}
@@ -0,0 +1,70 @@
+package command
+
+import (
+	"testing"
+
+	"github.com/buchorim/arngit/internal/ui"
+)
+
+func TestRenderBarScalesToWidth(t *testing.T) {
+	tests := []struct {
+		count, max, width int
+		wantFilled        int
+	}{
+		{count: 0, max: 10, width: 20, wantFilled: 0},
+		{count: 10, max: 10, width: 20, wantFilled: 20},
+		{count: 5, max: 10, width: 20, wantFilled: 10},
+		{count: 1000000, max: 1000000, width: 20, wantFilled: 20},
+	}
+
+	renderer := ui.NewRenderer(false, "")
+	for _, tt := range tests {
+		bar := renderBar(renderer, tt.count, tt.max, tt.width)
+		if len([]rune(bar)) != tt.width {
+			t.Errorf("renderBar(%d,%d,%d) length = %d, want %d", tt.count, tt.max, tt.width, len([]rune(bar)), tt.width)
+		}
+
+		filled := 0
+		for _, r := range bar {
+			if r == '█' {
+				filled++
+			}
+		}
+		if filled != tt.wantFilled {
+			t.Errorf("renderBar(%d,%d,%d) filled = %d, want %d", tt.count, tt.max, tt.width, filled, tt.wantFilled)
+		}
+	}
+}
+
+func TestRenderBarUsesASCIISymbolsInASCIIOnlyMode(t *testing.T) {
+	renderer := ui.NewRenderer(false, "")
+	renderer.ASCIIOnly = true
+
+	bar := renderBar(renderer, 5, 10, 10)
+	if bar != "#####-----" {
+		t.Errorf("renderBar in ASCII-only mode = %q, want %q", bar, "#####-----")
+	}
+}
+
+func TestSortedAuthorsByCount(t *testing.T) {
+	got := sortedAuthorsByCount(map[string]int{"Alice": 3, "Bob": 10, "Carol": 1})
+	want := []string{"Bob", "Alice", "Carol"}
+	if len(got) != len(want) {
+		t.Fatalf("got %v, want %v", got, want)
+	}
+	for i := range want {
+		if got[i] != want[i] {
+			t.Errorf("got %v, want %v", got, want)
+			break
+		}
+	}
+}
+
+func TestMaxInt(t *testing.T) {
+	if got := maxInt([]int{3, 1, 4, 1, 5}); got != 5 {
+		t.Errorf("maxInt = %d, want 5", got)
+	}
+	if got := maxInt(nil); got != 0 {
+		t.Errorf("maxInt(nil) = %d, want 0", got)
+	}
+}
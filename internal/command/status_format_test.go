@@ -0,0 +1,131 @@
+package command
+
+import (
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/buchorim/arngit/internal/core"
+)
+
+// setupStatusTestRepo builds a repo with one staged file, one modified but
+// unstaged tracked file, and one untracked file.
+func setupStatusTestRepo(t *testing.T) string {
+	t.Helper()
+	if _, err := exec.LookPath("git"); err != nil {
+		t.Skip("git not installed")
+	}
+
+	dir := t.TempDir()
+	run := func(args ...string) {
+		cmd := exec.Command("git", args...)
+		cmd.Dir = dir
+		if out, err := cmd.CombinedOutput(); err != nil {
+			t.Fatalf("git %v: %v: %s", args, err, out)
+		}
+	}
+	write := func(name, content string) {
+		if err := os.WriteFile(filepath.Join(dir, name), []byte(content), 0o644); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	run("init", "-q", "-b", "main")
+	run("config", "user.email", "test@example.com")
+	run("config", "user.name", "test")
+	write("tracked.txt", "base\n")
+	run("add", ".")
+	run("commit", "-q", "-m", "base")
+
+	write("tracked.txt", "changed\n")
+	write("staged.txt", "new\n")
+	run("add", "staged.txt")
+	write("untracked.txt", "new\n")
+
+	return dir
+}
+
+func TestHandleStatusShortMatchesPorcelain(t *testing.T) {
+	dir := setupStatusTestRepo(t)
+	chdir(t, dir)
+
+	router := NewRouter(&core.Engine{Config: core.DefaultConfig()}, "test")
+	short := captureStdout(t, func() {
+		if err := router.Dispatch([]string{"status", "--short"}); err != nil {
+			t.Fatalf("status --short: %v", err)
+		}
+	})
+	porcelain := captureStdout(t, func() {
+		if err := router.Dispatch([]string{"status", "--porcelain"}); err != nil {
+			t.Fatalf("status --porcelain: %v", err)
+		}
+	})
+	if strings.TrimSpace(short) != strings.TrimSpace(porcelain) {
+		t.Fatalf("--short and --porcelain differ:\nshort: %q\nporcelain: %q", short, porcelain)
+	}
+	if !strings.Contains(short, "staged.txt") || !strings.Contains(short, "untracked.txt") {
+		t.Fatalf("expected raw output to list all changed paths, got %q", short)
+	}
+}
+
+func TestHandleStatusRejectsShortAndPorcelainTogether(t *testing.T) {
+	dir := setupStatusTestRepo(t)
+	chdir(t, dir)
+
+	router := NewRouter(&core.Engine{Config: core.DefaultConfig()}, "test")
+	if err := router.Dispatch([]string{"status", "--short", "--porcelain"}); err == nil {
+		t.Fatal("expected an error combining --short and --porcelain")
+	}
+}
+
+func TestHandleStatusVerboseGroupsBySection(t *testing.T) {
+	dir := setupStatusTestRepo(t)
+	chdir(t, dir)
+
+	router := NewRouter(&core.Engine{Config: core.DefaultConfig()}, "test")
+	out := captureStdout(t, func() {
+		if err := router.Dispatch([]string{"status"}); err != nil {
+			t.Fatalf("status: %v", err)
+		}
+	})
+
+	for _, want := range []string{"Staged:", "staged.txt", "Modified:", "tracked.txt", "Untracked:", "untracked.txt"} {
+		if !strings.Contains(out, want) {
+			t.Fatalf("expected verbose status to contain %q, got:\n%s", want, out)
+		}
+	}
+	if strings.Contains(out, "Conflicted:") {
+		t.Fatalf("did not expect a Conflicted section with no conflicts, got:\n%s", out)
+	}
+}
+
+func TestHandleStatusReportsCleanWorkingTree(t *testing.T) {
+	dir := setupStatusTestRepo(t)
+	chdir(t, dir)
+	run := func(args ...string) {
+		cmd := exec.Command("git", args...)
+		cmd.Dir = dir
+		if out, err := cmd.CombinedOutput(); err != nil {
+			t.Fatalf("git %v: %v: %s", args, err, out)
+		}
+	}
+	run("reset", "--", "staged.txt")
+	for _, name := range []string{"staged.txt", "untracked.txt"} {
+		if err := os.Remove(filepath.Join(dir, name)); err != nil {
+			t.Fatal(err)
+		}
+	}
+	run("checkout", "--", "tracked.txt")
+
+	router := NewRouter(&core.Engine{Config: core.DefaultConfig()}, "test")
+	out := captureStdout(t, func() {
+		if err := router.Dispatch([]string{"status"}); err != nil {
+			t.Fatalf("status: %v", err)
+		}
+	})
+	if !strings.Contains(out, "working tree clean") {
+		t.Fatalf("expected a clean working tree message, got:\n%s", out)
+	}
+}
@@ -0,0 +1,135 @@
+package command
+
+import (
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"sort"
+
+	"github.com/buchorim/arngit/internal/ui"
+)
+
+// registerStorageCommands wires up `arngit storage`.
+func (r *Router) registerStorageCommands() {
+	r.register("storage", handleStorage)
+}
+
+// handleStorage implements `arngit storage` and `arngit storage clean`.
+func handleStorage(ctx *Context) error {
+	if len(ctx.Args) > 0 && ctx.Args[0] == "clean" {
+		return storageClean(ctx)
+	}
+	return storageShow(ctx)
+}
+
+func storageShow(ctx *Context) error {
+	home := ctx.Engine.Home()
+	entries, err := os.ReadDir(home)
+	if err != nil {
+		return err
+	}
+
+	var total int64
+	sizes := make(map[string]int64, len(entries))
+	for _, e := range entries {
+		size, err := dirSize(filepath.Join(home, e.Name()))
+		if err != nil {
+			return err
+		}
+		sizes[e.Name()] = size
+		total += size
+	}
+
+	names := make([]string, 0, len(sizes))
+	for name := range sizes {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	for _, name := range names {
+		ctx.Renderer.Plain(fmt.Sprintf("%-20s %10s  %s", name, humanSize(sizes[name]), usageBar(ctx.Renderer, sizes[name], total, 20)))
+	}
+	ctx.Renderer.Plain(fmt.Sprintf("%-20s %10s", "total", humanSize(total)))
+	return nil
+}
+
+// usageBar renders a filled/empty block bar showing size's share of total,
+// e.g. "████████░░░░░░░░░░░░", using r's symbol set (see Renderer.ASCIIOnly).
+func usageBar(r *ui.Renderer, size, total int64, width int) string {
+	if total <= 0 {
+		return r.Bar(0, width)
+	}
+	filled := int(float64(size) / float64(total) * float64(width))
+	return r.Bar(filled, width)
+}
+
+// storageClean removes rotated log backups (arngit.log.N) and the contents
+// of the response cache directory, if present.
+func storageClean(ctx *Context) error {
+	home := ctx.Engine.Home()
+
+	logsDir := filepath.Join(home, "logs")
+	matches, err := filepath.Glob(filepath.Join(logsDir, "*.log.*"))
+	if err != nil {
+		return err
+	}
+	for _, m := range matches {
+		if err := os.Remove(m); err != nil {
+			return err
+		}
+	}
+
+	cacheDir := filepath.Join(home, "cache")
+	if _, err := os.Stat(cacheDir); err == nil {
+		if err := os.RemoveAll(cacheDir); err != nil {
+			return err
+		}
+		if err := os.MkdirAll(cacheDir, 0o700); err != nil {
+			return err
+		}
+	}
+
+	ctx.Renderer.Success(fmt.Sprintf("removed %d rotated log file(s) and cleared the response cache", len(matches)))
+	return nil
+}
+
+// dirSize sums file sizes under path. A missing path yields 0.
+func dirSize(path string) (int64, error) {
+	var total int64
+	err := filepath.WalkDir(path, func(_ string, d fs.DirEntry, err error) error {
+		if err != nil {
+			if os.IsNotExist(err) {
+				return nil
+			}
+			return err
+		}
+		if d.IsDir() {
+			return nil
+		}
+		info, err := d.Info()
+		if err != nil {
+			return err
+		}
+		total += info.Size()
+		return nil
+	})
+	if os.IsNotExist(err) {
+		return 0, nil
+	}
+	return total, err
+}
+
+// humanSize renders bytes as a short human-readable size, e.g. "12.3 KB".
+func humanSize(bytes int64) string {
+	const unit = 1024
+	if bytes < unit {
+		return fmt.Sprintf("%d B", bytes)
+	}
+	div, exp := int64(unit), 0
+	for n := bytes / unit; n >= unit; n /= unit {
+		div *= unit
+		exp++
+	}
+	return fmt.Sprintf("%.1f %cB", float64(bytes)/float64(div), "KMGTPE"[exp])
+}
@@ -0,0 +1,77 @@
+package command
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"unicode/utf8"
+
+	"github.com/buchorim/arngit/internal/ui"
+)
+
+func TestUsageBar(t *testing.T) {
+	renderer := ui.NewRenderer(false, "")
+
+	bar := usageBar(renderer, 50, 100, 20)
+	if !utf8.ValidString(bar) {
+		t.Fatalf("usageBar produced invalid UTF-8: %q", bar)
+	}
+	if got := utf8.RuneCountInString(bar); got != 20 {
+		t.Fatalf("usageBar rune count = %d, want 20", got)
+	}
+
+	if usageBar(renderer, 0, 0, 20) != "░░░░░░░░░░░░░░░░░░░░" {
+		t.Fatalf("usageBar with zero total should be all empty, got %q", usageBar(renderer, 0, 0, 20))
+	}
+	if usageBar(renderer, 200, 100, 20) != "████████████████████" {
+		t.Fatalf("usageBar should clamp overflow to full, got %q", usageBar(renderer, 200, 100, 20))
+	}
+}
+
+func TestUsageBarUsesASCIISymbolsInASCIIOnlyMode(t *testing.T) {
+	renderer := ui.NewRenderer(false, "")
+	renderer.ASCIIOnly = true
+
+	if got := usageBar(renderer, 50, 100, 10); got != "#####-----" {
+		t.Fatalf("usageBar in ASCII-only mode = %q, want %q", got, "#####-----")
+	}
+}
+
+func TestHumanSize(t *testing.T) {
+	tests := map[int64]string{
+		0:       "0 B",
+		1023:    "1023 B",
+		1024:    "1.0 KB",
+		1 << 20: "1.0 MB",
+	}
+	for bytes, want := range tests {
+		if got := humanSize(bytes); got != want {
+			t.Errorf("humanSize(%d) = %q, want %q", bytes, got, want)
+		}
+	}
+}
+
+func TestDirSize(t *testing.T) {
+	dir := t.TempDir()
+	if err := os.WriteFile(filepath.Join(dir, "a.txt"), []byte("hello"), 0o644); err != nil {
+		t.Fatalf("WriteFile: %v", err)
+	}
+
+	size, err := dirSize(dir)
+	if err != nil {
+		t.Fatalf("dirSize: %v", err)
+	}
+	if size != 5 {
+		t.Fatalf("dirSize() = %d, want 5", size)
+	}
+}
+
+func TestDirSizeMissing(t *testing.T) {
+	size, err := dirSize(filepath.Join(t.TempDir(), "does-not-exist"))
+	if err != nil {
+		t.Fatalf("dirSize: %v", err)
+	}
+	if size != 0 {
+		t.Fatalf("dirSize() = %d, want 0", size)
+	}
+}
@@ -0,0 +1,56 @@
+package command
+
+// suggestThreshold is the maximum edit distance (relative to the typed
+// name's length) still worth surfacing as "did you mean"; beyond this the
+// match is too far off to be helpful.
+const suggestThreshold = 2
+
+// suggest returns the registered command name closest to name by edit
+// distance, or "" if nothing is close enough to be worth suggesting.
+func (r *Router) suggest(name string) string {
+	best, bestDistance := "", suggestThreshold+1
+	for candidate := range r.handlers {
+		if d := levenshtein(name, candidate); d < bestDistance {
+			best, bestDistance = candidate, d
+		}
+	}
+	return best
+}
+
+// levenshtein returns the edit distance between a and b: the minimum
+// number of single-character insertions, deletions, or substitutions
+// needed to turn one into the other.
+func levenshtein(a, b string) int {
+	ar, br := []rune(a), []rune(b)
+
+	prev := make([]int, len(br)+1)
+	for j := range prev {
+		prev[j] = j
+	}
+
+	for i := 1; i <= len(ar); i++ {
+		curr := make([]int, len(br)+1)
+		curr[0] = i
+		for j := 1; j <= len(br); j++ {
+			cost := 1
+			if ar[i-1] == br[j-1] {
+				cost = 0
+			}
+			curr[j] = min3(curr[j-1]+1, prev[j]+1, prev[j-1]+cost)
+		}
+		prev = curr
+	}
+
+	return prev[len(br)]
+}
+
+func min3(a, b, c int) int {
+	m := a
+	if b < m {
+		m = b
+	}
+	if c < m {
+		m = c
+	}
+	return m
+}
@@ -0,0 +1,52 @@
+package command
+
+import (
+	"testing"
+
+	"github.com/buchorim/arngit/internal/core"
+)
+
+func TestLevenshtein(t *testing.T) {
+	cases := []struct {
+		a, b string
+		want int
+	}{
+		{"status", "status", 0},
+		{"comit", "commit", 1},
+		{"stauts", "status", 2},
+		{"kitten", "sitting", 3},
+	}
+	for _, c := range cases {
+		if got := levenshtein(c.a, c.b); got != c.want {
+			t.Errorf("levenshtein(%q, %q) = %d, want %d", c.a, c.b, got, c.want)
+		}
+	}
+}
+
+func TestSuggestFindsCloseTypo(t *testing.T) {
+	router := NewRouter(&core.Engine{Config: core.DefaultConfig()}, "test")
+
+	if got := router.suggest("comit"); got != "commit" {
+		t.Errorf("suggest(%q) = %q, want %q", "comit", got, "commit")
+	}
+}
+
+func TestSuggestReturnsNothingForFarOffInput(t *testing.T) {
+	router := NewRouter(&core.Engine{Config: core.DefaultConfig()}, "test")
+
+	if got := router.suggest("xyzzy-totally-unrelated"); got != "" {
+		t.Errorf("suggest(%q) = %q, want no suggestion", "xyzzy-totally-unrelated", got)
+	}
+}
+
+func TestDispatchUnknownCommandIncludesSuggestion(t *testing.T) {
+	router := NewRouter(&core.Engine{Config: core.DefaultConfig()}, "test")
+
+	err := router.Dispatch([]string{"comit"})
+	if err == nil {
+		t.Fatal("expected an error for an unknown command")
+	}
+	if got := err.Error(); got == "" {
+		t.Fatal("expected a non-empty error message")
+	}
+}
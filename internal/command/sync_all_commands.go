@@ -0,0 +1,113 @@
+package command
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/buchorim/arngit/internal/core"
+	"github.com/buchorim/arngit/internal/git"
+)
+
+// registerSyncAllCommands wires up `arngit sync-all`.
+func (r *Router) registerSyncAllCommands() {
+	r.register("sync-all", handleSyncAll)
+}
+
+// syncResult is one row of the `sync-all` report table.
+type syncResult struct {
+	repo   string
+	status string
+	detail string
+}
+
+// handleSyncAll implements `arngit sync-all <dir>`: it finds every git repo
+// under dir, fetches and fast-forward-pulls each in parallel (skipping ones
+// with a dirty working tree), and prints a per-repo status table.
+func handleSyncAll(ctx *Context) error {
+	if len(ctx.Args) == 0 {
+		return fmt.Errorf("usage: arngit sync-all <dir>")
+	}
+	root := ctx.Args[0]
+
+	repos, err := discoverRepos(root)
+	if err != nil {
+		return err
+	}
+	sort.Strings(repos)
+
+	results := make([]syncResult, len(repos))
+	tasks := make([]func() error, len(repos))
+	for i, repoDir := range repos {
+		i, repoDir := i, repoDir
+		tasks[i] = func() error {
+			results[i] = syncRepo(repoDir)
+			return nil
+		}
+	}
+
+	core.NewPool(4).Run(tasks)
+
+	for _, res := range results {
+		ctx.Renderer.Plain(fmt.Sprintf("%-10s %-40s %s", res.status, res.repo, res.detail))
+	}
+	return nil
+}
+
+// discoverRepos returns the directories directly containing a .git entry
+// under root, without descending into repos it's already found.
+func discoverRepos(root string) ([]string, error) {
+	var repos []string
+	err := filepath.WalkDir(root, func(path string, d os.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if !d.IsDir() {
+			return nil
+		}
+		if _, err := os.Stat(filepath.Join(path, ".git")); err == nil {
+			repos = append(repos, path)
+			return filepath.SkipDir
+		}
+		return nil
+	})
+	return repos, err
+}
+
+// syncRepo fetches and pulls a single repo, classifying the outcome.
+func syncRepo(dir string) syncResult {
+	name := filepath.Base(dir)
+	svc := git.NewService(dir)
+
+	status, err := svc.Status()
+	if err != nil {
+		return syncResult{repo: name, status: "error", detail: err.Error()}
+	}
+	if status != "" {
+		return syncResult{repo: name, status: "skipped", detail: "dirty working tree"}
+	}
+
+	if _, err := svc.Fetch(); err != nil {
+		return syncResult{repo: name, status: "error", detail: err.Error()}
+	}
+
+	out, err := svc.Pull(git.PullOptions{})
+	return classifyPullResult(name, out, err)
+}
+
+// classifyPullResult turns a `git pull` outcome into a syncResult, kept
+// separate from syncRepo so it can be tested without shelling out.
+func classifyPullResult(name, output string, err error) syncResult {
+	if err != nil {
+		if strings.Contains(err.Error(), "conflict") || strings.Contains(err.Error(), "Automatic merge failed") {
+			return syncResult{repo: name, status: "conflict", detail: err.Error()}
+		}
+		return syncResult{repo: name, status: "error", detail: err.Error()}
+	}
+	if strings.Contains(output, "Already up to date") {
+		return syncResult{repo: name, status: "clean", detail: ""}
+	}
+	return syncResult{repo: name, status: "updated", detail: output}
+}
@@ -0,0 +1,67 @@
+package command
+
+import (
+	"errors"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"testing"
+)
+
+func TestClassifyPullResult(t *testing.T) {
+	if got := classifyPullResult("r", "Already up to date.", nil); got.status != "clean" {
+		t.Errorf("status = %q, want clean", got.status)
+	}
+	if got := classifyPullResult("r", "Updating a1b2..c3d4\nFast-forward", nil); got.status != "updated" {
+		t.Errorf("status = %q, want updated", got.status)
+	}
+	if got := classifyPullResult("r", "", errors.New("Automatic merge failed")); got.status != "conflict" {
+		t.Errorf("status = %q, want conflict", got.status)
+	}
+	if got := classifyPullResult("r", "", errors.New("network unreachable")); got.status != "error" {
+		t.Errorf("status = %q, want error", got.status)
+	}
+}
+
+func initTestRepo(t *testing.T, dir string) {
+	t.Helper()
+	run := func(args ...string) {
+		cmd := exec.Command("git", args...)
+		cmd.Dir = dir
+		if out, err := cmd.CombinedOutput(); err != nil {
+			t.Fatalf("git %v: %v: %s", args, err, out)
+		}
+	}
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		t.Fatal(err)
+	}
+	run("init", "-q")
+	run("config", "user.email", "test@example.com")
+	run("config", "user.name", "test")
+	if err := os.WriteFile(filepath.Join(dir, "README.md"), []byte("hi"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	run("add", ".")
+	run("commit", "-q", "-m", "init")
+}
+
+func TestDiscoverRepos(t *testing.T) {
+	if _, err := exec.LookPath("git"); err != nil {
+		t.Skip("git not installed")
+	}
+
+	parent := t.TempDir()
+	initTestRepo(t, filepath.Join(parent, "a"))
+	initTestRepo(t, filepath.Join(parent, "b"))
+	if err := os.MkdirAll(filepath.Join(parent, "not-a-repo"), 0o755); err != nil {
+		t.Fatal(err)
+	}
+
+	repos, err := discoverRepos(parent)
+	if err != nil {
+		t.Fatalf("discoverRepos: %v", err)
+	}
+	if len(repos) != 2 {
+		t.Fatalf("discoverRepos returned %d repos, want 2: %v", len(repos), repos)
+	}
+}
@@ -0,0 +1,105 @@
+package command
+
+import (
+	"fmt"
+
+	"github.com/buchorim/arngit/internal/git"
+)
+
+// syncFlags declares the flags handleSync accepts.
+var syncFlags = []FlagSpec{
+	{Name: "abort", Description: "Abort an in-progress rebase or merge left by a previous sync"},
+}
+
+// registerSyncCommands wires up `arngit sync`.
+func (r *Router) registerSyncCommands() {
+	r.registerCommand(Command{
+		Name:         "sync",
+		Handler:      handleSync,
+		RequiresRepo: true,
+		Flags:        syncFlags,
+	})
+}
+
+// handleSync implements `arngit sync`: fetch, then rebase onto the pulled
+// branch, falling back to a merge if the rebase itself couldn't even start
+// (e.g. there's nothing to rebase yet). If either leaves the repo mid
+// rebase/merge on a conflict, sync stops and points at `--abort` instead of
+// trying the other strategy on top of unresolved state.
+func handleSync(ctx *Context) error {
+	flags := parseFlags(syncFlags, ctx.Args)
+	if flags.Bool["abort"] {
+		return syncAbort(ctx)
+	}
+
+	if ctx.Git.RebaseInProgress() {
+		return fmt.Errorf("a rebase is already in progress here: resolve the conflicts and run `git rebase --continue`, or `arngit sync --abort` to give up")
+	}
+	if ctx.Git.MergeInProgress() {
+		return fmt.Errorf("a merge is already in progress here: resolve the conflicts, `arngit add`, and `arngit commit`, or `arngit sync --abort` to give up")
+	}
+
+	if ctx.DryRun {
+		ctx.Renderer.Info("dry run: would run git fetch, then git pull --rebase (falling back to a merge)")
+		return nil
+	}
+
+	if _, err := ctx.Git.Fetch(); err != nil {
+		return err
+	}
+
+	out, err := ctx.Git.Pull(git.PullOptions{Rebase: true})
+	if err == nil {
+		ctx.Renderer.Success("synced (rebase)")
+		if out != "" {
+			ctx.Renderer.Plain(out)
+		}
+		return nil
+	}
+
+	if ctx.Git.RebaseInProgress() {
+		return fmt.Errorf("rebase hit a conflict: resolve the conflicting files, `arngit add`, then `git rebase --continue`, or `arngit sync --abort` to give up: %w", err)
+	}
+
+	out, err = ctx.Git.Pull(git.PullOptions{})
+	if err != nil {
+		if ctx.Git.MergeInProgress() {
+			return fmt.Errorf("merge hit a conflict: resolve the conflicting files, `arngit add`, then `arngit commit`, or `arngit sync --abort` to give up: %w", err)
+		}
+		return err
+	}
+	ctx.Renderer.Success("synced (merge)")
+	if out != "" {
+		ctx.Renderer.Plain(out)
+	}
+	return nil
+}
+
+// syncAbort implements `arngit sync --abort`, aborting whichever of a
+// rebase or merge is currently in progress.
+func syncAbort(ctx *Context) error {
+	switch {
+	case ctx.Git.RebaseInProgress():
+		out, err := ctx.Git.RebaseAbort()
+		if err != nil {
+			return err
+		}
+		ctx.Renderer.Success("rebase aborted")
+		if out != "" {
+			ctx.Renderer.Plain(out)
+		}
+		return nil
+	case ctx.Git.MergeInProgress():
+		out, err := ctx.Git.MergeAbort()
+		if err != nil {
+			return err
+		}
+		ctx.Renderer.Success("merge aborted")
+		if out != "" {
+			ctx.Renderer.Plain(out)
+		}
+		return nil
+	default:
+		return fmt.Errorf("no rebase or merge is in progress")
+	}
+}
@@ -0,0 +1,91 @@
+package command
+
+import (
+	"os"
+	"os/exec"
+	"path/filepath"
+	"testing"
+
+	"github.com/buchorim/arngit/internal/core"
+)
+
+// setupSyncConflictRepo builds a repo with two branches that conflict on the
+// same file, checked out on "main" with "feature" ready to clash against it.
+func setupSyncConflictRepo(t *testing.T) string {
+	t.Helper()
+	if _, err := exec.LookPath("git"); err != nil {
+		t.Skip("git not installed")
+	}
+
+	dir := t.TempDir()
+	run := func(args ...string) {
+		cmd := exec.Command("git", args...)
+		cmd.Dir = dir
+		if out, err := cmd.CombinedOutput(); err != nil {
+			t.Fatalf("git %v: %v: %s", args, err, out)
+		}
+	}
+	write := func(content string) {
+		if err := os.WriteFile(filepath.Join(dir, "f.txt"), []byte(content), 0o644); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	run("init", "-q", "-b", "main")
+	run("config", "user.email", "test@example.com")
+	run("config", "user.name", "test")
+	write("base\n")
+	run("add", ".")
+	run("commit", "-q", "-m", "base")
+
+	run("checkout", "-q", "-b", "feature")
+	write("feature change\n")
+	run("add", ".")
+	run("commit", "-q", "-m", "feature change")
+
+	run("checkout", "-q", "main")
+	write("main change\n")
+	run("add", ".")
+	run("commit", "-q", "-m", "main change")
+
+	return dir
+}
+
+func TestSyncAbortWithNothingInProgress(t *testing.T) {
+	dir := setupSyncConflictRepo(t)
+	chdir(t, dir)
+
+	router := NewRouter(&core.Engine{Config: core.DefaultConfig()}, "test")
+	if err := router.Dispatch([]string{"sync", "--abort"}); err == nil {
+		t.Fatal("expected an error aborting with nothing in progress")
+	}
+}
+
+func TestSyncRefusesToRunWhileRebaseInProgress(t *testing.T) {
+	dir := setupSyncConflictRepo(t)
+	cmd := exec.Command("git", "rebase", "feature")
+	cmd.Dir = dir
+	cmd.Run() // expected to conflict; ignore the error
+
+	chdir(t, dir)
+	router := NewRouter(&core.Engine{Config: core.DefaultConfig()}, "test")
+	if err := router.Dispatch([]string{"sync"}); err == nil {
+		t.Fatal("expected sync to refuse to run while a rebase is in progress")
+	}
+}
+
+func TestSyncAbortClearsInProgressRebase(t *testing.T) {
+	dir := setupSyncConflictRepo(t)
+	cmd := exec.Command("git", "rebase", "feature")
+	cmd.Dir = dir
+	cmd.Run() // expected to conflict; ignore the error
+
+	chdir(t, dir)
+	router := NewRouter(&core.Engine{Config: core.DefaultConfig()}, "test")
+	if err := router.Dispatch([]string{"sync", "--abort"}); err != nil {
+		t.Fatalf("sync --abort: %v", err)
+	}
+	if err := router.Dispatch([]string{"sync", "--abort"}); err == nil {
+		t.Fatal("expected the second --abort to find nothing in progress")
+	}
+}
@@ -0,0 +1,396 @@
+package command
+
+import (
+	"fmt"
+	"os"
+	"os/exec"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/buchorim/arngit/internal/core"
+	"github.com/buchorim/arngit/internal/ui"
+)
+
+// validBranchNamePattern is a practical subset of git's own
+// check-ref-format rules: no whitespace or most ref-syntax punctuation, and
+// no leading/trailing separator.
+var validBranchNamePattern = regexp.MustCompile(`^[A-Za-z0-9]([A-Za-z0-9._/-]*[A-Za-z0-9])?$`)
+
+// isValidBranchName reports whether name could plausibly be a git ref name,
+// for validating default_branch without needing a repository to check
+// against.
+func isValidBranchName(name string) bool {
+	if name == "" || strings.Contains(name, "..") || strings.Contains(name, "//") {
+		return false
+	}
+	return validBranchNamePattern.MatchString(name)
+}
+
+// registerSystemCommands wires up config and other system-level commands.
+func (r *Router) registerSystemCommands() {
+	r.registerCommand(Command{
+		Name:        "config",
+		Handler:     handleConfig,
+		Subcommands: []string{"set", "unset", "reset", "edit", "profile"},
+	})
+}
+
+// configKeys lists the keys configSet accepts, used both for its error
+// messages and for interactive-mode tab completion.
+var configKeys = []string{
+	"default_account",
+	"default_branch",
+	"color_output",
+	"ascii_only",
+	"theme",
+	"compact_mode",
+	"update_channel",
+	"update_interval",
+	"dashboard_greeting",
+	"git_timeout",
+	"network_timeout",
+	"http_proxy",
+	"auto_stage",
+	"push_after_commit",
+	"max_file_size",
+	"scan_secrets",
+	"pull_strategy",
+}
+
+// handleConfig implements `arngit config`, `arngit config set <key>
+// <value>`, and the `arngit config profile ...` family.
+func handleConfig(ctx *Context) error {
+	if len(ctx.Args) == 0 {
+		return showConfig(ctx)
+	}
+
+	switch ctx.Args[0] {
+	case "set":
+		return configSet(ctx, ctx.Args[1:])
+	case "unset":
+		return configUnset(ctx, ctx.Args[1:])
+	case "reset":
+		return configReset(ctx)
+	case "edit":
+		return configEdit(ctx)
+	case "profile":
+		return configProfile(ctx, ctx.Args[1:])
+	default:
+		return fmt.Errorf("unknown config subcommand: %s", ctx.Args[0])
+	}
+}
+
+func showConfig(ctx *Context) error {
+	cfg := ctx.Engine.Config
+	colorOutput := "auto"
+	if cfg.ColorOutput != nil {
+		colorOutput = fmt.Sprintf("%t", *cfg.ColorOutput)
+	}
+	asciiOnly := "auto"
+	if cfg.ASCIIOnly != nil {
+		asciiOnly = fmt.Sprintf("%t", *cfg.ASCIIOnly)
+	}
+	ctx.Renderer.Plain(fmt.Sprintf("config_version:  %d", cfg.ConfigVersion))
+	ctx.Renderer.Plain(fmt.Sprintf("default_account: %s", cfg.DefaultAccount))
+	ctx.Renderer.Plain(fmt.Sprintf("default_branch:  %s", cfg.DefaultBranch))
+	ctx.Renderer.Plain(fmt.Sprintf("color_output:    %s", colorOutput))
+	ctx.Renderer.Plain(fmt.Sprintf("ascii_only:      %s", asciiOnly))
+	ctx.Renderer.Plain(fmt.Sprintf("theme:           %s", cfg.Theme))
+	ctx.Renderer.Plain(fmt.Sprintf("compact_mode:    %t", cfg.CompactMode))
+	ctx.Renderer.Plain(fmt.Sprintf("update_channel:  %s", cfg.UpdateChannel))
+	ctx.Renderer.Plain(fmt.Sprintf("update_interval: %s", cfg.UpdateInterval))
+	ctx.Renderer.Plain(fmt.Sprintf("dashboard_greeting: %s", cfg.DashboardGreeting))
+	ctx.Renderer.Plain(fmt.Sprintf("git_timeout:     %s", cfg.GitTimeout))
+	ctx.Renderer.Plain(fmt.Sprintf("network_timeout: %s", cfg.NetworkTimeout))
+	ctx.Renderer.Plain(fmt.Sprintf("http_proxy:      %s", cfg.HTTPProxy))
+	ctx.Renderer.Plain(fmt.Sprintf("auto_stage:      %t", cfg.AutoStage))
+	ctx.Renderer.Plain(fmt.Sprintf("push_after_commit: %t", cfg.PushAfterCommit))
+	ctx.Renderer.Plain(fmt.Sprintf("max_file_size:   %d", cfg.MaxFileSize))
+	ctx.Renderer.Plain(fmt.Sprintf("scan_secrets:    %t", cfg.ScanSecrets))
+	ctx.Renderer.Plain(fmt.Sprintf("pull_strategy:   %s", cfg.PullStrategy))
+	return nil
+}
+
+func configSet(ctx *Context, args []string) error {
+	if len(args) != 2 {
+		return fmt.Errorf("usage: arngit config set <key> <value>")
+	}
+	key, value := args[0], args[1]
+
+	switch key {
+	case "default_account":
+		ctx.Engine.Config.DefaultAccount = value
+	case "default_branch":
+		if !isValidBranchName(value) {
+			return fmt.Errorf("default_branch %q is not a valid branch name", value)
+		}
+		ctx.Engine.Config.DefaultBranch = value
+	case "color_output":
+		if value == "auto" {
+			ctx.Engine.Config.ColorOutput = nil
+		} else {
+			enabled := value == "true"
+			ctx.Engine.Config.ColorOutput = &enabled
+		}
+	case "ascii_only":
+		if value == "auto" {
+			ctx.Engine.Config.ASCIIOnly = nil
+		} else {
+			enabled := value == "true"
+			ctx.Engine.Config.ASCIIOnly = &enabled
+		}
+	case "theme":
+		names := ui.ThemeNames()
+		if !contains(names, value) {
+			return fmt.Errorf("theme must be one of %s, got %q", strings.Join(names, ", "), value)
+		}
+		ctx.Engine.Config.Theme = value
+	case "compact_mode":
+		ctx.Engine.Config.CompactMode = value == "true"
+	case "update_channel":
+		if !contains([]string{"stable", "beta", "nightly"}, value) {
+			return fmt.Errorf("update_channel must be one of stable, beta, nightly, got %q", value)
+		}
+		ctx.Engine.Config.UpdateChannel = value
+	case "update_interval":
+		if value != "" {
+			d, err := time.ParseDuration(value)
+			if err != nil {
+				return fmt.Errorf("update_interval must be a duration like \"24h\" (or empty): %w", err)
+			}
+			if d < 0 {
+				return fmt.Errorf("update_interval must not be negative, got %q", value)
+			}
+		}
+		ctx.Engine.Config.UpdateInterval = value
+	case "dashboard_greeting":
+		if value != "fixed" && value != "random" {
+			return fmt.Errorf("dashboard_greeting must be \"fixed\" or \"random\", got %q", value)
+		}
+		ctx.Engine.Config.DashboardGreeting = value
+	case "git_timeout":
+		if value != "" {
+			if _, err := time.ParseDuration(value); err != nil {
+				return fmt.Errorf("git_timeout must be a duration like \"30s\" (or empty): %w", err)
+			}
+		}
+		ctx.Engine.Config.GitTimeout = value
+	case "network_timeout":
+		if value != "" {
+			if _, err := time.ParseDuration(value); err != nil {
+				return fmt.Errorf("network_timeout must be a duration like \"30s\" (or empty): %w", err)
+			}
+		}
+		ctx.Engine.Config.NetworkTimeout = value
+	case "http_proxy":
+		ctx.Engine.Config.HTTPProxy = value
+	case "auto_stage":
+		ctx.Engine.Config.AutoStage = value == "true"
+	case "push_after_commit":
+		ctx.Engine.Config.PushAfterCommit = value == "true"
+	case "max_file_size":
+		size, err := strconv.ParseInt(value, 10, 64)
+		if err != nil || size < 0 {
+			return fmt.Errorf("max_file_size must be a non-negative number of bytes, got %q", value)
+		}
+		ctx.Engine.Config.MaxFileSize = size
+	case "scan_secrets":
+		ctx.Engine.Config.ScanSecrets = value == "true"
+	case "pull_strategy":
+		if !contains([]string{"merge", "rebase", "ff-only"}, value) {
+			return fmt.Errorf("pull_strategy must be one of merge, rebase, ff-only, got %q", value)
+		}
+		ctx.Engine.Config.PullStrategy = value
+	default:
+		return fmt.Errorf("unknown config key: %s", key)
+	}
+
+	if err := ctx.Engine.SaveConfig(); err != nil {
+		return err
+	}
+	ctx.Renderer.Success(fmt.Sprintf("set %s = %s", key, value))
+	return nil
+}
+
+// configUnset implements `config unset <key>`, restoring key to the value
+// DefaultConfig gives it, by reusing configSet's validation and save path.
+func configUnset(ctx *Context, args []string) error {
+	if len(args) != 1 {
+		return fmt.Errorf("usage: arngit config unset <key>")
+	}
+	key := args[0]
+
+	value, err := defaultConfigValue(key)
+	if err != nil {
+		return err
+	}
+	return configSet(ctx, []string{key, value})
+}
+
+// defaultConfigValue returns the string form of key's value in
+// DefaultConfig, i.e. what configSet would need to be told to restore it.
+func defaultConfigValue(key string) (string, error) {
+	d := core.DefaultConfig()
+	switch key {
+	case "default_account":
+		return d.DefaultAccount, nil
+	case "default_branch":
+		return d.DefaultBranch, nil
+	case "color_output":
+		return "auto", nil
+	case "ascii_only":
+		return "auto", nil
+	case "theme":
+		return d.Theme, nil
+	case "compact_mode":
+		return fmt.Sprintf("%t", d.CompactMode), nil
+	case "update_channel":
+		return d.UpdateChannel, nil
+	case "update_interval":
+		return d.UpdateInterval, nil
+	case "dashboard_greeting":
+		return d.DashboardGreeting, nil
+	case "git_timeout":
+		return d.GitTimeout, nil
+	case "network_timeout":
+		return d.NetworkTimeout, nil
+	case "http_proxy":
+		return d.HTTPProxy, nil
+	case "auto_stage":
+		return fmt.Sprintf("%t", d.AutoStage), nil
+	case "push_after_commit":
+		return fmt.Sprintf("%t", d.PushAfterCommit), nil
+	case "max_file_size":
+		return strconv.FormatInt(d.MaxFileSize, 10), nil
+	case "scan_secrets":
+		return fmt.Sprintf("%t", d.ScanSecrets), nil
+	case "pull_strategy":
+		return d.PullStrategy, nil
+	default:
+		return "", fmt.Errorf("unknown config key: %s", key)
+	}
+}
+
+// configReset implements `config reset`: back up the active profile's
+// current config file, then overwrite it with fresh defaults.
+func configReset(ctx *Context) error {
+	if !ctx.Confirm("reset all config values to their defaults?") {
+		return nil
+	}
+
+	path, err := ctx.Engine.Profiles.ActiveConfigPath()
+	if err != nil {
+		return err
+	}
+
+	if data, err := os.ReadFile(path); err == nil {
+		backupPath := path + ".bak"
+		if err := os.WriteFile(backupPath, data, 0o644); err != nil {
+			return fmt.Errorf("backing up config before reset: %w", err)
+		}
+		ctx.Renderer.Info(fmt.Sprintf("backed up previous config to %s", backupPath))
+	} else if !os.IsNotExist(err) {
+		return err
+	}
+
+	defaults := core.DefaultConfig()
+	if err := defaults.Save(path); err != nil {
+		return err
+	}
+	ctx.Engine.Config = defaults
+
+	ctx.Renderer.Success("reset config to defaults")
+	return nil
+}
+
+// configEdit implements `config edit`: open the active profile's config
+// file in $EDITOR (falling back to vi), then reload and validate it,
+// keeping the previous config in place if the edit left it invalid.
+func configEdit(ctx *Context) error {
+	path, err := ctx.Engine.Profiles.ActiveConfigPath()
+	if err != nil {
+		return err
+	}
+
+	original, err := os.ReadFile(path)
+	if err != nil && !os.IsNotExist(err) {
+		return err
+	}
+
+	editor := os.Getenv("EDITOR")
+	if editor == "" {
+		editor = "vi"
+	}
+
+	cmd := exec.Command(editor, path)
+	cmd.Stdin = os.Stdin
+	cmd.Stdout = os.Stdout
+	cmd.Stderr = os.Stderr
+	if err := cmd.Run(); err != nil {
+		return fmt.Errorf("running %s: %w", editor, err)
+	}
+
+	edited, err := core.LoadConfig(path)
+	if err != nil {
+		if writeErr := os.WriteFile(path, original, 0o644); writeErr != nil {
+			return fmt.Errorf("%w (and failed to restore the previous config: %v)", err, writeErr)
+		}
+		return fmt.Errorf("invalid config, reverted: %w", err)
+	}
+
+	ctx.Engine.Config = edited
+	ctx.Renderer.Success("config updated")
+	return nil
+}
+
+// configProfile implements `config profile list|use|create`.
+func configProfile(ctx *Context, args []string) error {
+	if len(args) == 0 {
+		return fmt.Errorf("usage: arngit config profile <list|use|create> [name]")
+	}
+
+	switch args[0] {
+	case "list":
+		names, err := ctx.Engine.Profiles.List()
+		if err != nil {
+			return err
+		}
+		active, err := ctx.Engine.Profiles.Active()
+		if err != nil {
+			return err
+		}
+		for _, name := range names {
+			marker := "  "
+			if name == active {
+				marker = "* "
+			}
+			ctx.Renderer.Plain(marker + name)
+		}
+		return nil
+
+	case "create":
+		if len(args) != 2 {
+			return fmt.Errorf("usage: arngit config profile create <name>")
+		}
+		if err := ctx.Engine.Profiles.Create(args[1]); err != nil {
+			return err
+		}
+		ctx.Renderer.Success(fmt.Sprintf("created profile %q", args[1]))
+		return nil
+
+	case "use":
+		if len(args) != 2 {
+			return fmt.Errorf("usage: arngit config profile use <name>")
+		}
+		if err := ctx.Engine.Profiles.SetActive(args[1]); err != nil {
+			return err
+		}
+		ctx.Renderer.Success(fmt.Sprintf("switched to profile %q", args[1]))
+		return nil
+
+	default:
+		return fmt.Errorf("unknown config profile subcommand: %s", args[0])
+	}
+}
@@ -0,0 +1,185 @@
+package command
+
+import (
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/buchorim/arngit/internal/core"
+)
+
+func TestConfigSetValidation(t *testing.T) {
+	tests := []struct {
+		name    string
+		key     string
+		value   string
+		wantErr bool
+	}{
+		{"valid update_channel", "update_channel", "beta", false},
+		{"invalid update_channel", "update_channel", "banana", true},
+		{"valid theme", "theme", "dark", false},
+		{"invalid theme", "theme", "nonexistent", true},
+		{"valid update_interval", "update_interval", "1h", false},
+		{"negative update_interval", "update_interval", "-1h", true},
+		{"malformed update_interval", "update_interval", "banana", true},
+		{"valid default_branch", "default_branch", "main", false},
+		{"invalid default_branch with space", "default_branch", "not a branch", true},
+		{"invalid default_branch with dotdot", "default_branch", "feature/..evil", true},
+		{"valid pull_strategy", "pull_strategy", "rebase", false},
+		{"invalid pull_strategy", "pull_strategy", "squash", true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			profiles, err := core.NewProfileManager(t.TempDir())
+			if err != nil {
+				t.Fatalf("NewProfileManager: %v", err)
+			}
+			router := NewRouter(&core.Engine{Config: core.DefaultConfig(), Profiles: profiles}, "test")
+			err = router.Dispatch([]string{"config", "set", tt.key, tt.value})
+			if tt.wantErr && err == nil {
+				t.Fatalf("config set %s %q: expected an error, got none", tt.key, tt.value)
+			}
+			if !tt.wantErr && err != nil {
+				t.Fatalf("config set %s %q: unexpected error: %v", tt.key, tt.value, err)
+			}
+		})
+	}
+}
+
+func TestConfigUnsetRestoresDefault(t *testing.T) {
+	profiles, err := core.NewProfileManager(t.TempDir())
+	if err != nil {
+		t.Fatalf("NewProfileManager: %v", err)
+	}
+	router := NewRouter(&core.Engine{Config: core.DefaultConfig(), Profiles: profiles}, "test")
+
+	if err := router.Dispatch([]string{"config", "set", "theme", "dark"}); err != nil {
+		t.Fatalf("config set theme dark: %v", err)
+	}
+	if got, want := router.engine.Config.Theme, "dark"; got != want {
+		t.Fatalf("Theme = %q, want %q", got, want)
+	}
+
+	if err := router.Dispatch([]string{"config", "unset", "theme"}); err != nil {
+		t.Fatalf("config unset theme: %v", err)
+	}
+	if got, want := router.engine.Config.Theme, core.DefaultConfig().Theme; got != want {
+		t.Fatalf("Theme after unset = %q, want default %q", got, want)
+	}
+}
+
+func TestConfigResetBacksUpAndRestoresDefaults(t *testing.T) {
+	profiles, err := core.NewProfileManager(t.TempDir())
+	if err != nil {
+		t.Fatalf("NewProfileManager: %v", err)
+	}
+	router := NewRouter(&core.Engine{Config: core.DefaultConfig(), Profiles: profiles}, "test")
+
+	if err := router.Dispatch([]string{"config", "set", "theme", "dark"}); err != nil {
+		t.Fatalf("config set theme dark: %v", err)
+	}
+
+	path, err := profiles.ActiveConfigPath()
+	if err != nil {
+		t.Fatalf("ActiveConfigPath: %v", err)
+	}
+
+	if err := router.Dispatch([]string{"config", "reset", "--yes"}); err != nil {
+		t.Fatalf("config reset: %v", err)
+	}
+
+	if got, want := router.engine.Config.Theme, core.DefaultConfig().Theme; got != want {
+		t.Fatalf("Theme after reset = %q, want default %q", got, want)
+	}
+
+	backup, err := os.ReadFile(path + ".bak")
+	if err != nil {
+		t.Fatalf("reading backup: %v", err)
+	}
+	if !strings.Contains(string(backup), "theme: dark") {
+		t.Fatalf("backup = %q, want it to contain the pre-reset theme value", backup)
+	}
+}
+
+// writeFakeEditor writes an executable shell script that overwrites its
+// first argument with content, standing in for $EDITOR in tests.
+func writeFakeEditor(t *testing.T, content string) string {
+	t.Helper()
+	path := filepath.Join(t.TempDir(), "fake-editor.sh")
+	script := "#!/bin/sh\nprintf '%s' " + shellQuote(content) + " > \"$1\"\n"
+	if err := os.WriteFile(path, []byte(script), 0o755); err != nil {
+		t.Fatal(err)
+	}
+	return path
+}
+
+func shellQuote(s string) string {
+	return "'" + strings.ReplaceAll(s, "'", `'\''`) + "'"
+}
+
+func TestConfigEditRejectsInvalidYAMLAndKeepsPreviousConfig(t *testing.T) {
+	if _, err := exec.LookPath("sh"); err != nil {
+		t.Skip("sh not installed")
+	}
+
+	profiles, err := core.NewProfileManager(t.TempDir())
+	if err != nil {
+		t.Fatalf("NewProfileManager: %v", err)
+	}
+	router := NewRouter(&core.Engine{Config: core.DefaultConfig(), Profiles: profiles}, "test")
+
+	if err := router.Dispatch([]string{"config", "set", "theme", "dark"}); err != nil {
+		t.Fatalf("config set theme dark: %v", err)
+	}
+	path, err := profiles.ActiveConfigPath()
+	if err != nil {
+		t.Fatalf("ActiveConfigPath: %v", err)
+	}
+	before, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatalf("reading config before edit: %v", err)
+	}
+
+	fakeEditor := writeFakeEditor(t, "theme: [not valid\n")
+	t.Setenv("EDITOR", fakeEditor)
+
+	if err := router.Dispatch([]string{"config", "edit"}); err == nil {
+		t.Fatal("expected config edit to reject invalid YAML")
+	}
+
+	after, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatalf("reading config after rejected edit: %v", err)
+	}
+	if string(after) != string(before) {
+		t.Fatalf("config file changed despite invalid edit: before %q, after %q", before, after)
+	}
+	if got, want := router.engine.Config.Theme, "dark"; got != want {
+		t.Fatalf("in-memory Theme = %q, want unchanged %q", got, want)
+	}
+}
+
+func TestConfigEditAcceptsValidYAML(t *testing.T) {
+	if _, err := exec.LookPath("sh"); err != nil {
+		t.Skip("sh not installed")
+	}
+
+	profiles, err := core.NewProfileManager(t.TempDir())
+	if err != nil {
+		t.Fatalf("NewProfileManager: %v", err)
+	}
+	router := NewRouter(&core.Engine{Config: core.DefaultConfig(), Profiles: profiles}, "test")
+
+	fakeEditor := writeFakeEditor(t, "theme: light\n")
+	t.Setenv("EDITOR", fakeEditor)
+
+	if err := router.Dispatch([]string{"config", "edit"}); err != nil {
+		t.Fatalf("config edit: %v", err)
+	}
+	if got, want := router.engine.Config.Theme, "light"; got != want {
+		t.Fatalf("Theme after edit = %q, want %q", got, want)
+	}
+}
@@ -0,0 +1,71 @@
+package command
+
+import (
+	"fmt"
+	"os"
+	"os/exec"
+)
+
+// templateCandidates lists the well-known paths GitHub itself recognizes for
+// each kind of template, checked in order.
+var templateCandidates = map[string][]string{
+	"pr":    {".github/PULL_REQUEST_TEMPLATE.md", ".github/pull_request_template.md", "PULL_REQUEST_TEMPLATE.md"},
+	"issue": {".github/ISSUE_TEMPLATE.md", ".github/issue_template.md", "ISSUE_TEMPLATE.md"},
+}
+
+// findTemplate looks for a PR or issue template (kind is "pr" or "issue")
+// at its well-known repo paths and returns its contents. It returns an
+// empty string, with no error, if no template is present.
+func findTemplate(kind string) (string, error) {
+	candidates, ok := templateCandidates[kind]
+	if !ok {
+		return "", fmt.Errorf("unknown template kind: %s", kind)
+	}
+	for _, path := range candidates {
+		data, err := os.ReadFile(path)
+		if err == nil {
+			return string(data), nil
+		}
+		if !os.IsNotExist(err) {
+			return "", err
+		}
+	}
+	return "", nil
+}
+
+// editTemplate writes seed to a temp file, opens it in $EDITOR (falling
+// back to vi), and returns the edited contents.
+func editTemplate(seed string) (string, error) {
+	f, err := os.CreateTemp("", "arngit-template-*.md")
+	if err != nil {
+		return "", err
+	}
+	path := f.Name()
+	defer os.Remove(path)
+	if _, err := f.WriteString(seed); err != nil {
+		f.Close()
+		return "", err
+	}
+	if err := f.Close(); err != nil {
+		return "", err
+	}
+
+	editor := os.Getenv("EDITOR")
+	if editor == "" {
+		editor = "vi"
+	}
+
+	cmd := exec.Command(editor, path)
+	cmd.Stdin = os.Stdin
+	cmd.Stdout = os.Stdout
+	cmd.Stderr = os.Stderr
+	if err := cmd.Run(); err != nil {
+		return "", fmt.Errorf("running %s: %w", editor, err)
+	}
+
+	edited, err := os.ReadFile(path)
+	if err != nil {
+		return "", err
+	}
+	return string(edited), nil
+}
@@ -0,0 +1,62 @@
+package command
+
+import (
+	"os"
+	"os/exec"
+	"path/filepath"
+	"testing"
+)
+
+func TestFindTemplateLoadsPresentTemplate(t *testing.T) {
+	dir := t.TempDir()
+	if err := os.MkdirAll(filepath.Join(dir, ".github"), 0o755); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(filepath.Join(dir, ".github", "PULL_REQUEST_TEMPLATE.md"), []byte("## Summary\n"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	chdir(t, dir)
+
+	got, err := findTemplate("pr")
+	if err != nil {
+		t.Fatalf("findTemplate: %v", err)
+	}
+	if got != "## Summary\n" {
+		t.Errorf("got %q", got)
+	}
+}
+
+func TestFindTemplateReturnsEmptyWhenAbsent(t *testing.T) {
+	chdir(t, t.TempDir())
+
+	got, err := findTemplate("pr")
+	if err != nil {
+		t.Fatalf("findTemplate: %v", err)
+	}
+	if got != "" {
+		t.Errorf("got %q, want empty", got)
+	}
+}
+
+func TestFindTemplateRejectsUnknownKind(t *testing.T) {
+	if _, err := findTemplate("bogus"); err == nil {
+		t.Fatal("expected an error for an unknown template kind")
+	}
+}
+
+func TestEditTemplateLoadsTemplateIntoBody(t *testing.T) {
+	if _, err := exec.LookPath("sh"); err != nil {
+		t.Skip("sh not installed")
+	}
+
+	fakeEditor := writeFakeEditor(t, "## Summary\nfilled in by reviewer\n")
+	t.Setenv("EDITOR", fakeEditor)
+
+	body, err := editTemplate("## Summary\n")
+	if err != nil {
+		t.Fatalf("editTemplate: %v", err)
+	}
+	if body != "## Summary\nfilled in by reviewer\n" {
+		t.Errorf("body = %q", body)
+	}
+}
@@ -0,0 +1,73 @@
+package command
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/buchorim/arngit/internal/git"
+)
+
+// registerUndoCommands wires up `arngit undo`.
+func (r *Router) registerUndoCommands() {
+	r.registerRepoCommand("undo", handleUndo)
+}
+
+// recordJournal appends a best-effort operation journal entry for a
+// mutating command, so `undo` and `journal` have something to work with. A
+// journal write failure is never worth failing the command that triggered
+// it, so it's silently ignored.
+func recordJournal(ctx *Context, command string, args []string, head string) {
+	_ = ctx.Git.AppendJournal(git.JournalEntry{
+		Time:    time.Now(),
+		Command: command,
+		Args:    args,
+		Head:    head,
+	})
+}
+
+// handleUndo implements `arngit undo`: reverse the most recent mutating
+// arngit command recorded in the operation journal. It currently
+// understands "commit" (soft reset to the commit before it, keeping the
+// changes staged) and "add" (unstage everything), the two commands that
+// journal entries so far.
+func handleUndo(ctx *Context) error {
+	entry, ok, err := ctx.Git.LastJournalEntry()
+	if err != nil {
+		return err
+	}
+	if !ok {
+		return fmt.Errorf("nothing to undo: no journaled arngit command found for this repo")
+	}
+
+	switch entry.Command {
+	case "commit":
+		head, err := ctx.Git.ResolveRef("HEAD")
+		if err != nil {
+			return err
+		}
+		if head != entry.Head {
+			return fmt.Errorf("the last journaled commit is no longer at HEAD; nothing to undo")
+		}
+		if ctx.DryRun {
+			ctx.Renderer.Info("dry run: would run git reset --soft HEAD~1")
+			return nil
+		}
+		if _, err := ctx.Git.ResetSoft("HEAD~1"); err != nil {
+			return err
+		}
+		ctx.Renderer.Success("undid commit, keeping its changes staged")
+		return nil
+	case "add":
+		if ctx.DryRun {
+			ctx.Renderer.Info("dry run: would run git reset --mixed HEAD")
+			return nil
+		}
+		if _, err := ctx.Git.ResetMixed("HEAD"); err != nil {
+			return err
+		}
+		ctx.Renderer.Success("unstaged changes")
+		return nil
+	default:
+		return fmt.Errorf("don't know how to undo the last journaled command (%q)", entry.Command)
+	}
+}
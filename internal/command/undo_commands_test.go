@@ -0,0 +1,121 @@
+package command
+
+import (
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/buchorim/arngit/internal/core"
+)
+
+func setupUndoTestRepo(t *testing.T) string {
+	t.Helper()
+	if _, err := exec.LookPath("git"); err != nil {
+		t.Skip("git not installed")
+	}
+
+	dir := t.TempDir()
+	run := func(args ...string) {
+		cmd := exec.Command("git", args...)
+		cmd.Dir = dir
+		if out, err := cmd.CombinedOutput(); err != nil {
+			t.Fatalf("git %v: %v: %s", args, err, out)
+		}
+	}
+	run("init", "-q", "-b", "main")
+	run("config", "user.email", "test@example.com")
+	run("config", "user.name", "test")
+	if err := os.WriteFile(filepath.Join(dir, "base.txt"), []byte("base\n"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	run("add", ".")
+	run("commit", "-q", "-m", "base")
+
+	return dir
+}
+
+func TestUndoRejectsWithNoJournaledCommand(t *testing.T) {
+	dir := setupUndoTestRepo(t)
+	chdir(t, dir)
+
+	router := NewRouter(&core.Engine{Config: core.DefaultConfig()}, "test")
+	if err := router.Dispatch([]string{"undo"}); err == nil {
+		t.Fatal("expected an error undoing with nothing journaled")
+	}
+}
+
+func TestUndoSoftResetsLastCommit(t *testing.T) {
+	dir := setupUndoTestRepo(t)
+	chdir(t, dir)
+
+	if err := os.WriteFile(filepath.Join(dir, "feature.txt"), []byte("feature\n"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	router := NewRouter(&core.Engine{Config: core.DefaultConfig()}, "test")
+	if err := router.Dispatch([]string{"add", "feature.txt"}); err != nil {
+		t.Fatalf("add: %v", err)
+	}
+	if err := router.Dispatch([]string{"commit", "-m", "add feature"}); err != nil {
+		t.Fatalf("commit: %v", err)
+	}
+
+	logOut, err := exec.Command("git", "-C", dir, "log", "--oneline").CombinedOutput()
+	if err != nil {
+		t.Fatalf("git log: %v: %s", err, logOut)
+	}
+	if strings.Count(strings.TrimSpace(string(logOut)), "\n")+1 != 2 {
+		t.Fatalf("expected 2 commits before undo, got:\n%s", logOut)
+	}
+
+	if err := router.Dispatch([]string{"undo"}); err != nil {
+		t.Fatalf("undo: %v", err)
+	}
+
+	logOut, err = exec.Command("git", "-C", dir, "log", "--oneline").CombinedOutput()
+	if err != nil {
+		t.Fatalf("git log: %v: %s", err, logOut)
+	}
+	if strings.Count(strings.TrimSpace(string(logOut)), "\n")+1 != 1 {
+		t.Fatalf("expected 1 commit after undo, got:\n%s", logOut)
+	}
+
+	statusOut, err := exec.Command("git", "-C", dir, "diff", "--cached", "--name-only").CombinedOutput()
+	if err != nil {
+		t.Fatalf("git diff --cached: %v: %s", err, statusOut)
+	}
+	if !strings.Contains(string(statusOut), "feature.txt") {
+		t.Fatalf("expected feature.txt to remain staged after undo, got:\n%s", statusOut)
+	}
+
+	if err := router.Dispatch([]string{"undo"}); err == nil {
+		t.Fatal("expected the second undo to find nothing to reverse")
+	}
+}
+
+func TestUndoUnstagesLastAdd(t *testing.T) {
+	dir := setupUndoTestRepo(t)
+	chdir(t, dir)
+
+	if err := os.WriteFile(filepath.Join(dir, "feature.txt"), []byte("feature\n"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	router := NewRouter(&core.Engine{Config: core.DefaultConfig()}, "test")
+	if err := router.Dispatch([]string{"add", "feature.txt"}); err != nil {
+		t.Fatalf("add: %v", err)
+	}
+	if err := router.Dispatch([]string{"undo"}); err != nil {
+		t.Fatalf("undo: %v", err)
+	}
+
+	statusOut, err := exec.Command("git", "-C", dir, "diff", "--cached", "--name-only").CombinedOutput()
+	if err != nil {
+		t.Fatalf("git diff --cached: %v: %s", err, statusOut)
+	}
+	if strings.TrimSpace(string(statusOut)) != "" {
+		t.Fatalf("expected nothing staged after undoing add, got:\n%s", statusOut)
+	}
+}
@@ -0,0 +1,104 @@
+package command
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/buchorim/arngit/internal/update"
+)
+
+// registerUpdateCommands wires up `arngit update`.
+func (r *Router) registerUpdateCommands() {
+	r.register("update", handleUpdate)
+}
+
+// handleUpdate checks GitHub Releases for a newer version, downloads the
+// matching binary asset alongside its checksums.txt, and refuses to apply
+// anything whose SHA-256 doesn't match what the release published.
+func handleUpdate(ctx *Context) error {
+	token := ""
+	if ctx.Engine.Accounts.Active != "" {
+		if t, err := ctx.Engine.Accounts.Token(ctx.Engine.Accounts.Active); err == nil {
+			token = t
+		}
+	}
+	networkTimeout, _ := time.ParseDuration(ctx.Engine.Config.NetworkTimeout)
+	mgr, err := update.NewManager(ctx.Version, token, networkTimeout, ctx.Engine.Config.HTTPProxy)
+	if err != nil {
+		return fmt.Errorf("configuring update client: %w", err)
+	}
+	mgr.Channel = ctx.Engine.Config.UpdateChannel
+
+	release, err := mgr.LatestRelease()
+	if err != nil {
+		return fmt.Errorf("checking for updates: %w", err)
+	}
+
+	if release.TagName == ctx.Version {
+		ctx.Renderer.Success(fmt.Sprintf("already on the latest version (%s)", ctx.Version))
+		return nil
+	}
+
+	assetName := update.AssetName()
+	asset, err := release.FindAsset(assetName)
+	if err != nil {
+		return err
+	}
+	checksums, err := release.FindAsset("checksums.txt")
+	if err != nil {
+		return err
+	}
+
+	destDir := filepath.Join(ctx.Engine.Home(), "update")
+	binaryPath, err := mgr.Download(asset.BrowserDownloadURL, destDir)
+	if err != nil {
+		return fmt.Errorf("downloading %s: %w", assetName, err)
+	}
+	checksumsPath, err := mgr.Download(checksums.BrowserDownloadURL, destDir)
+	if err != nil {
+		return fmt.Errorf("downloading checksums: %w", err)
+	}
+
+	data, err := os.ReadFile(checksumsPath)
+	if err != nil {
+		return err
+	}
+	expected, ok := update.ParseChecksums(data)[assetName]
+	if !ok {
+		return fmt.Errorf("checksums.txt has no entry for %s", assetName)
+	}
+
+	if err := update.VerifyChecksum(binaryPath, expected); err != nil {
+		os.Remove(binaryPath)
+		os.Remove(checksumsPath)
+		return err
+	}
+
+	if !hasFlag(ctx.Args, "--apply") {
+		ctx.Renderer.Success(fmt.Sprintf("downloaded and verified %s (%s)", release.TagName, assetName))
+		ctx.Renderer.Info("run `arngit update --apply` to install it")
+		return nil
+	}
+
+	if !ctx.Confirm(fmt.Sprintf("Install %s over the running binary?", release.TagName)) {
+		ctx.Renderer.Warn("update cancelled")
+		return nil
+	}
+
+	if err := update.ApplyToRunningBinary(binaryPath); err != nil {
+		return fmt.Errorf("applying update: %w", err)
+	}
+	ctx.Renderer.Success(fmt.Sprintf("updated to %s", release.TagName))
+	return nil
+}
+
+func hasFlag(args []string, flag string) bool {
+	for _, a := range args {
+		if a == flag {
+			return true
+		}
+	}
+	return false
+}
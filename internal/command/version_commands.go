@@ -0,0 +1,11 @@
+package command
+
+// registerVersionCommands wires up `arngit version`.
+func (r *Router) registerVersionCommands() {
+	r.register("version", handleVersion)
+}
+
+func handleVersion(ctx *Context) error {
+	ctx.Renderer.Plain(ctx.Version)
+	return nil
+}
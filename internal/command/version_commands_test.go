@@ -0,0 +1,14 @@
+package command
+
+import (
+	"testing"
+
+	"github.com/buchorim/arngit/internal/core"
+)
+
+func TestHandleVersionPrintsVersion(t *testing.T) {
+	router := NewRouter(&core.Engine{Config: core.DefaultConfig()}, "1.2.3")
+	if err := router.Dispatch([]string{"version"}); err != nil {
+		t.Fatalf("Dispatch: %v", err)
+	}
+}
@@ -0,0 +1,159 @@
+package command
+
+import (
+	"errors"
+	"fmt"
+	"net/url"
+	"strconv"
+	"strings"
+
+	"github.com/buchorim/arngit/internal/github"
+)
+
+// webhookFlags declares the flags `arngit webhook add` accepts.
+var webhookFlags = []FlagSpec{
+	{Name: "events", Description: "Comma-separated events to deliver (default: push)", TakesValue: true},
+	{Name: "secret", Description: "Shared secret GitHub signs deliveries with", TakesValue: true},
+}
+
+// registerWebhookCommands wires up `arngit webhook`.
+func (r *Router) registerWebhookCommands() {
+	r.registerCommand(Command{
+		Name:            "webhook",
+		Handler:         handleWebhook,
+		RequiresRepo:    true,
+		RequiresAccount: true,
+		Subcommands:     []string{"list", "add", "remove"},
+		Flags:           webhookFlags,
+	})
+}
+
+// handleWebhook dispatches `arngit webhook <list|add|remove>`.
+func handleWebhook(ctx *Context) error {
+	if len(ctx.Args) == 0 {
+		return fmt.Errorf("usage: arngit webhook <list|add <url>|remove <id>>")
+	}
+	sub, rest := ctx.Args[0], ctx.Args[1:]
+
+	owner, repo, err := currentOwnerRepo(ctx)
+	if err != nil {
+		return err
+	}
+	client, err := ctx.Engine.GitHubClient()
+	if err != nil {
+		return err
+	}
+
+	switch sub {
+	case "list":
+		return webhookList(ctx, client, owner, repo)
+	case "add":
+		return webhookAdd(ctx, client, owner, repo, rest)
+	case "remove":
+		return webhookRemove(ctx, client, owner, repo, rest)
+	default:
+		return fmt.Errorf("unknown webhook subcommand: %s", sub)
+	}
+}
+
+func webhookList(ctx *Context, client *github.Client, owner, repo string) error {
+	hooks, err := client.ListWebhooks(owner, repo)
+	if err != nil {
+		return wrapGitHubError(err)
+	}
+	if len(hooks) == 0 {
+		ctx.Renderer.Plain("no webhooks configured")
+		return nil
+	}
+	for _, h := range hooks {
+		ctx.Renderer.Plain(fmt.Sprintf("%d  %s  events=%s  active=%t", h.ID, h.Config.URL, strings.Join(h.Events, ","), h.Active))
+	}
+	return nil
+}
+
+// validateWebhookURL requires an absolute http(s) URL, matching what
+// GitHub itself accepts as a payload URL.
+func validateWebhookURL(raw string) error {
+	parsed, err := url.Parse(raw)
+	if err != nil {
+		return fmt.Errorf("invalid webhook URL: %w", err)
+	}
+	if parsed.Scheme != "http" && parsed.Scheme != "https" {
+		return fmt.Errorf("webhook URL must be http or https, got %q", raw)
+	}
+	if parsed.Host == "" {
+		return fmt.Errorf("webhook URL must include a host: %q", raw)
+	}
+	return nil
+}
+
+// parseWebhookEvents splits a comma-separated events flag, rejecting an
+// empty list since GitHub requires at least one event to deliver.
+func parseWebhookEvents(raw string) ([]string, error) {
+	if strings.TrimSpace(raw) == "" {
+		return []string{"push"}, nil
+	}
+	var events []string
+	for _, e := range strings.Split(raw, ",") {
+		e = strings.TrimSpace(e)
+		if e == "" {
+			continue
+		}
+		events = append(events, e)
+	}
+	if len(events) == 0 {
+		return nil, errors.New("--events must list at least one event")
+	}
+	return events, nil
+}
+
+func webhookAdd(ctx *Context, client *github.Client, owner, repo string, args []string) error {
+	flags := parseFlags(webhookFlags, args)
+	if len(flags.Positional) != 1 {
+		return fmt.Errorf("usage: arngit webhook add <url> [--events=push,pull_request] [--secret=...]")
+	}
+	payloadURL := flags.Positional[0]
+	if err := validateWebhookURL(payloadURL); err != nil {
+		return err
+	}
+	events, err := parseWebhookEvents(flags.Value["events"])
+	if err != nil {
+		return err
+	}
+
+	if ctx.DryRun {
+		ctx.Renderer.Info(fmt.Sprintf("dry run: would create a webhook delivering %s to %s", strings.Join(events, ","), payloadURL))
+		return nil
+	}
+
+	hook, err := client.CreateWebhook(owner, repo, github.CreateWebhookRequest{
+		Active: true,
+		Events: events,
+		Config: github.WebhookConfig{URL: payloadURL, Secret: flags.Value["secret"]},
+	})
+	if err != nil {
+		return wrapGitHubError(err)
+	}
+	ctx.Renderer.Success(fmt.Sprintf("created webhook %d", hook.ID))
+	return nil
+}
+
+func webhookRemove(ctx *Context, client *github.Client, owner, repo string, args []string) error {
+	if len(args) != 1 {
+		return fmt.Errorf("usage: arngit webhook remove <id>")
+	}
+	id, err := strconv.ParseInt(args[0], 10, 64)
+	if err != nil {
+		return fmt.Errorf("invalid webhook id %q: %w", args[0], err)
+	}
+
+	if ctx.DryRun {
+		ctx.Renderer.Info(fmt.Sprintf("dry run: would remove webhook %d", id))
+		return nil
+	}
+	if err := client.DeleteWebhook(owner, repo, id); err != nil {
+		return wrapGitHubError(err)
+	}
+	ctx.Renderer.Success(fmt.Sprintf("removed webhook %d", id))
+	return nil
+}
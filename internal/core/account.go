@@ -0,0 +1,151 @@
+package core
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/json"
+	"errors"
+	"io"
+	"os"
+	"os/user"
+	"runtime"
+)
+
+// Account is a saved GitHub identity: a friendly name, the GitHub username
+// it maps to, and an AES-256-GCM encrypted personal access token.
+type Account struct {
+	Name           string `json:"name"`
+	Username       string `json:"username"`
+	EncryptedToken []byte `json:"encrypted_token"`
+	Nonce          []byte `json:"nonce"`
+}
+
+// AccountStore is the on-disk collection of saved accounts plus which one
+// is currently active.
+type AccountStore struct {
+	Accounts []Account `json:"accounts"`
+	Active   string    `json:"active"`
+
+	path string
+}
+
+// LoadAccountStore reads the account file at path. A missing file yields an
+// empty store rather than an error, matching first-run behavior.
+func LoadAccountStore(path string) (*AccountStore, error) {
+	store := &AccountStore{path: path}
+
+	data, err := os.ReadFile(path)
+	if os.IsNotExist(err) {
+		return store, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	if err := json.Unmarshal(data, store); err != nil {
+		return nil, err
+	}
+	store.path = path
+	return store, nil
+}
+
+// Save writes the store back to disk with owner-only permissions, since it
+// contains encrypted tokens.
+func (s *AccountStore) Save() error {
+	data, err := json.MarshalIndent(s, "", "  ")
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(s.path, data, 0o600)
+}
+
+// Add encrypts token and stores it under name, replacing any existing
+// account of the same name.
+func (s *AccountStore) Add(name, username, token string) error {
+	key := machineKey()
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return err
+	}
+	gcm, err := cipher.NewGCM(block)
+	if err != nil {
+		return err
+	}
+
+	nonce := make([]byte, gcm.NonceSize())
+	if _, err := io.ReadFull(rand.Reader, nonce); err != nil {
+		return err
+	}
+
+	encrypted := gcm.Seal(nil, nonce, []byte(token), nil)
+
+	account := Account{
+		Name:           name,
+		Username:       username,
+		EncryptedToken: encrypted,
+		Nonce:          nonce,
+	}
+
+	for i, a := range s.Accounts {
+		if a.Name == name {
+			s.Accounts[i] = account
+			return nil
+		}
+	}
+	s.Accounts = append(s.Accounts, account)
+	return nil
+}
+
+// Token decrypts and returns the personal access token for the named account.
+func (s *AccountStore) Token(name string) (string, error) {
+	for _, a := range s.Accounts {
+		if a.Name != name {
+			continue
+		}
+
+		key := machineKey()
+		block, err := aes.NewCipher(key)
+		if err != nil {
+			return "", err
+		}
+		gcm, err := cipher.NewGCM(block)
+		if err != nil {
+			return "", err
+		}
+
+		plain, err := gcm.Open(nil, a.Nonce, a.EncryptedToken, nil)
+		if err != nil {
+			return "", err
+		}
+		return string(plain), nil
+	}
+	return "", errors.New("account not found: " + name)
+}
+
+// Remove deletes the named account, clearing Active if it was the active one.
+func (s *AccountStore) Remove(name string) {
+	for i, a := range s.Accounts {
+		if a.Name == name {
+			s.Accounts = append(s.Accounts[:i], s.Accounts[i+1:]...)
+			break
+		}
+	}
+	if s.Active == name {
+		s.Active = ""
+	}
+}
+
+// machineKey derives a stable, machine-bound 32-byte AES key so that
+// encrypted tokens can't be copied to another machine and decrypted.
+func machineKey() []byte {
+	seed := runtime.GOOS + runtime.GOARCH
+	if hostname, err := os.Hostname(); err == nil {
+		seed += hostname
+	}
+	if u, err := user.Current(); err == nil {
+		seed += u.Uid
+	}
+	sum := sha256.Sum256([]byte(seed))
+	return sum[:]
+}
@@ -0,0 +1,204 @@
+// Package core holds the state that persists across arngit invocations:
+// user configuration, saved accounts, and repository protection.
+package core
+
+import (
+	"fmt"
+	"os"
+	"time"
+
+	"gopkg.in/yaml.v3"
+)
+
+// currentConfigVersion is the schema version DefaultConfig and migrateConfig
+// bring every config up to. Bump it, and add a case to migrateConfig,
+// whenever a change needs to set a new default on existing installs rather
+// than just relying on the Go zero value.
+const currentConfigVersion = 1
+
+// Config is the set of user-editable settings stored in ~/.arngit/config.yaml.
+type Config struct {
+	// ConfigVersion tracks the config schema this file was last migrated
+	// to, so LoadConfig can upgrade older files (see migrateConfig)
+	// instead of silently running with stale defaults. Files written
+	// before this field existed unmarshal it as 0.
+	ConfigVersion int `yaml:"config_version"`
+
+	DefaultAccount string `yaml:"default_account"`
+	DefaultBranch  string `yaml:"default_branch"`
+
+	// ColorOutput is nil ("auto") until the user explicitly sets it via
+	// `config set color_output true|false`, in which case it always wins
+	// over TTY auto-detection.
+	ColorOutput *bool `yaml:"color_output,omitempty"`
+
+	// ASCIIOnly is nil ("auto") until the user explicitly sets it via
+	// `config set ascii_only true|false`, in which case it always wins
+	// over locale auto-detection (see ui.ResolveASCIIOnly).
+	ASCIIOnly *bool `yaml:"ascii_only,omitempty"`
+
+	Theme       string `yaml:"theme"`
+	CompactMode bool   `yaml:"compact_mode"`
+
+	// UpdateChannel is "stable" (GitHub's /releases/latest, no
+	// prereleases), "beta", or "nightly" (beta and nightly both fetch the
+	// full release list and pick the newest prerelease).
+	UpdateChannel string `yaml:"update_channel"`
+
+	// UpdateInterval bounds how often CheckUpdatesBackground actually hits
+	// the network, as a Go duration string (e.g. "24h"). Empty means check
+	// every time.
+	UpdateInterval string `yaml:"update_interval,omitempty"`
+
+	// DashboardGreeting is "random" (a different greeting each dashboard
+	// render) or "fixed" (always the same one), for users who find the
+	// randomness distracting.
+	DashboardGreeting string `yaml:"dashboard_greeting"`
+
+	// GitTimeout bounds how long a single git invocation may run, as a Go
+	// duration string (e.g. "30s"). Empty means no timeout.
+	GitTimeout string `yaml:"git_timeout,omitempty"`
+
+	// NetworkTimeout bounds network-bound operations specifically: git
+	// push/pull/fetch/clone and GitHub API requests. Empty falls back to
+	// each caller's own default.
+	NetworkTimeout string `yaml:"network_timeout,omitempty"`
+
+	// HTTPProxy overrides the proxy used for GitHub API requests and git's
+	// network commands. Empty means fall back to the environment
+	// (HTTPS_PROXY/HTTP_PROXY/NO_PROXY), which is honored either way.
+	HTTPProxy string `yaml:"http_proxy,omitempty"`
+
+	// AutoStage makes `commit` stage all changes (git add .) before
+	// committing, so users who always run `add` then `commit` can skip the
+	// first step.
+	AutoStage bool `yaml:"auto_stage"`
+
+	// PushAfterCommit makes `commit` push immediately after a successful
+	// commit, for users who treat commit+push as one motion.
+	PushAfterCommit bool `yaml:"push_after_commit"`
+
+	// MaxFileSize is the size in bytes above which `commit` warns and
+	// confirms before committing a staged file, matching what a
+	// well-behaved pre-commit hook would reject. 0 disables the check.
+	MaxFileSize int64 `yaml:"max_file_size,omitempty"`
+
+	// ScanSecrets makes `commit` scan the staged diff for likely secrets
+	// (see internal/command/scan_commands.go) and confirm before
+	// committing if it finds any. Off by default since it's a heuristic
+	// scan that can false-positive.
+	ScanSecrets bool `yaml:"scan_secrets"`
+
+	// PullStrategy is "merge" (git's own default), "rebase", or "ff-only",
+	// applied by `pull` when no --rebase/--ff-only flag overrides it.
+	PullStrategy string `yaml:"pull_strategy,omitempty"`
+}
+
+// DefaultConfig returns the settings a freshly initialized install starts with.
+func DefaultConfig() *Config {
+	return &Config{
+		ConfigVersion:     currentConfigVersion,
+		DefaultBranch:     "main",
+		Theme:             "default",
+		CompactMode:       false,
+		UpdateChannel:     "stable",
+		UpdateInterval:    "24h",
+		DashboardGreeting: "random",
+		MaxFileSize:       1 << 20,
+		PullStrategy:      "merge",
+	}
+}
+
+// LoadConfig reads the YAML config at path. A missing file is not an error;
+// it yields DefaultConfig so first-run works without any setup. A config
+// written by an older version is migrated to the current schema and saved
+// back to path before being returned.
+func LoadConfig(path string) (*Config, error) {
+	data, err := os.ReadFile(path)
+	if os.IsNotExist(err) {
+		return DefaultConfig(), nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	// DefaultConfig already sets ConfigVersion to currentConfigVersion, and
+	// yaml.Unmarshal only overwrites keys present in the file, so decoding
+	// straight onto cfg would hide a legacy file's real (missing-key, so
+	// zero) version. Decode the version alone first, onto a zero Config, so
+	// the migration check below sees what's actually on disk.
+	var onDisk Config
+	if err := yaml.Unmarshal(data, &onDisk); err != nil {
+		return nil, err
+	}
+
+	cfg := DefaultConfig()
+	if err := yaml.Unmarshal(data, cfg); err != nil {
+		return nil, err
+	}
+
+	if err := validateConfig(cfg); err != nil {
+		return nil, fmt.Errorf("invalid config at %s: %w", path, err)
+	}
+
+	if onDisk.ConfigVersion < currentConfigVersion {
+		migrateConfig(cfg)
+		if err := cfg.Save(path); err != nil {
+			return nil, err
+		}
+	}
+
+	return cfg, nil
+}
+
+// migrateConfig upgrades cfg in place from its current ConfigVersion to
+// currentConfigVersion, setting any new field's default where the old file
+// left it at its zero value. Each version bump gets its own case, applied
+// in order, so a file several versions behind still migrates correctly.
+func migrateConfig(cfg *Config) {
+	if cfg.ConfigVersion < 1 {
+		if cfg.UpdateInterval == "" {
+			cfg.UpdateInterval = "24h"
+		}
+		if cfg.DashboardGreeting == "" {
+			cfg.DashboardGreeting = "random"
+		}
+		if cfg.MaxFileSize == 0 {
+			cfg.MaxFileSize = 1 << 20
+		}
+	}
+	cfg.ConfigVersion = currentConfigVersion
+}
+
+// validateConfig rejects config values that are structurally invalid
+// (rather than merely unusual), so a corrupted or hand-edited config file
+// fails fast at load time instead of misbehaving later.
+func validateConfig(cfg *Config) error {
+	if cfg.UpdateInterval != "" {
+		d, err := time.ParseDuration(cfg.UpdateInterval)
+		if err != nil {
+			return fmt.Errorf("update_interval: %w", err)
+		}
+		if d < 0 {
+			return fmt.Errorf("update_interval must not be negative, got %q", cfg.UpdateInterval)
+		}
+	}
+	if cfg.MaxFileSize < 0 {
+		return fmt.Errorf("max_file_size must not be negative, got %d", cfg.MaxFileSize)
+	}
+	switch cfg.PullStrategy {
+	case "", "merge", "rebase", "ff-only":
+	default:
+		return fmt.Errorf("pull_strategy must be merge, rebase, or ff-only, got %q", cfg.PullStrategy)
+	}
+	return nil
+}
+
+// Save writes the config back to path as YAML.
+func (c *Config) Save(path string) error {
+	data, err := yaml.Marshal(c)
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(path, data, 0o644)
+}
@@ -0,0 +1,71 @@
+package core
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"gopkg.in/yaml.v3"
+)
+
+func TestLoadConfigMigratesOldVersion(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "config.yaml")
+	old := "default_branch: develop\ntheme: default\n"
+	if err := os.WriteFile(path, []byte(old), 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	cfg, err := LoadConfig(path)
+	if err != nil {
+		t.Fatalf("LoadConfig: %v", err)
+	}
+	if cfg.ConfigVersion != currentConfigVersion {
+		t.Errorf("ConfigVersion = %d, want %d", cfg.ConfigVersion, currentConfigVersion)
+	}
+	if cfg.UpdateInterval != "24h" {
+		t.Errorf("UpdateInterval = %q, want migrated default %q", cfg.UpdateInterval, "24h")
+	}
+	if cfg.DefaultBranch != "develop" {
+		t.Errorf("DefaultBranch = %q, want the pre-existing value preserved", cfg.DefaultBranch)
+	}
+
+	// Unmarshal onto a zero-value Config, not DefaultConfig(), so a file
+	// that was never actually rewritten by the migration (config_version
+	// and update_interval keys missing) can't be masked by defaults
+	// filling in the very values the migration is supposed to persist.
+	data, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatalf("reading migrated config: %v", err)
+	}
+	var reloaded Config
+	if err := yaml.Unmarshal(data, &reloaded); err != nil {
+		t.Fatalf("unmarshal saved config: %v", err)
+	}
+	if reloaded.ConfigVersion != currentConfigVersion {
+		t.Errorf("saved ConfigVersion = %d, want the migration persisted to disk", reloaded.ConfigVersion)
+	}
+	if reloaded.UpdateInterval != "24h" {
+		t.Errorf("saved UpdateInterval = %q, want the migrated default persisted to disk", reloaded.UpdateInterval)
+	}
+}
+
+func TestLoadConfigRejectsNegativeUpdateInterval(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "config.yaml")
+	if err := os.WriteFile(path, []byte("update_interval: -24h\n"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := LoadConfig(path); err == nil {
+		t.Fatal("expected an error for a negative update_interval")
+	}
+}
+
+func TestLoadConfigMissingFileYieldsDefaults(t *testing.T) {
+	cfg, err := LoadConfig(filepath.Join(t.TempDir(), "does-not-exist.yaml"))
+	if err != nil {
+		t.Fatalf("LoadConfig: %v", err)
+	}
+	if cfg.ConfigVersion != currentConfigVersion {
+		t.Errorf("ConfigVersion = %d, want %d", cfg.ConfigVersion, currentConfigVersion)
+	}
+}
@@ -0,0 +1,164 @@
+package core
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/buchorim/arngit/internal/github"
+	"github.com/buchorim/arngit/internal/logging"
+)
+
+// legacyConfigName is the single-file config path used before profile
+// support was added. If found on startup, it's migrated into the "default"
+// profile and removed.
+const legacyConfigName = "config.yaml"
+
+// Engine is the shared runtime state assembled once at startup and threaded
+// through the command router: user config, saved accounts, and the
+// protected-repo list. It also owns the ~/.arngit layout.
+type Engine struct {
+	Config    *Config
+	Accounts  *AccountStore
+	Protected *ProtectedList
+	Profiles  *ProfileManager
+	Logger    *logging.Logger
+
+	// NetworkTimeout is the resolved per-invocation network timeout (the
+	// global --timeout flag if given, else Config.NetworkTimeout parsed),
+	// set by the router alongside git.Service.NetworkTimeout so GitHubClient
+	// honors the same value git's own network commands do. Zero falls back
+	// to GitHubClient's own default.
+	NetworkTimeout time.Duration
+
+	// Version is the running arngit version, used to compare against the
+	// latest release in CheckUpdatesBackground. Set by main after NewEngine
+	// returns, since the version is baked in at build time rather than
+	// discovered at runtime.
+	Version string
+
+	home string
+
+	// updateCancel and updateDone back CheckUpdatesBackground/Close: cancel
+	// stops the background check (or prevents it from starting the network
+	// call at all if it hasn't fired yet), and done is closed once the
+	// goroutine has actually exited, for tests to wait on.
+	updateCancel context.CancelFunc
+	updateDone   chan struct{}
+}
+
+// NewEngine locates (creating if necessary) ~/.arngit and loads the active
+// profile's config, accounts, and the protected list from it.
+func NewEngine() (*Engine, error) {
+	home, err := os.UserHomeDir()
+	if err != nil {
+		return nil, err
+	}
+
+	root := filepath.Join(home, ".arngit")
+	if err := os.MkdirAll(root, 0o700); err != nil {
+		return nil, err
+	}
+
+	if err := migrateLegacyConfig(root); err != nil {
+		return nil, err
+	}
+
+	profiles, err := NewProfileManager(root)
+	if err != nil {
+		return nil, err
+	}
+
+	cfg, err := profiles.Load()
+	if err != nil {
+		return nil, err
+	}
+
+	accounts, err := LoadAccountStore(filepath.Join(root, "accounts.json"))
+	if err != nil {
+		return nil, err
+	}
+
+	protected, err := LoadProtectedList(filepath.Join(root, "protected.json"))
+	if err != nil {
+		return nil, err
+	}
+
+	logger, err := logging.NewLogger(filepath.Join(root, "logs", "arngit.log"))
+	if err != nil {
+		return nil, err
+	}
+
+	return &Engine{
+		Config:    cfg,
+		Accounts:  accounts,
+		Protected: protected,
+		Profiles:  profiles,
+		Logger:    logger,
+		home:      root,
+	}, nil
+}
+
+// migrateLegacyConfig moves a pre-profile ~/.arngit/config.yaml into the
+// "default" profile the first time NewProfileManager would otherwise create
+// one from scratch.
+func migrateLegacyConfig(root string) error {
+	legacyPath := filepath.Join(root, legacyConfigName)
+	if _, err := os.Stat(legacyPath); os.IsNotExist(err) {
+		return nil
+	}
+
+	defaultProfilePath := filepath.Join(root, "profiles", defaultProfile+".yaml")
+	if _, err := os.Stat(defaultProfilePath); err == nil {
+		// Already migrated.
+		return os.Remove(legacyPath)
+	}
+
+	cfg, err := LoadConfig(legacyPath)
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(filepath.Join(root, "profiles"), 0o700); err != nil {
+		return err
+	}
+	if err := cfg.Save(defaultProfilePath); err != nil {
+		return err
+	}
+	return os.Remove(legacyPath)
+}
+
+// Home returns the ~/.arngit directory this engine reads and writes.
+func (e *Engine) Home() string {
+	return e.home
+}
+
+// CacheDir returns the directory arngit stores cached, regenerable state
+// in (GitHub API response cache, interactive-mode history, and similar).
+func (e *Engine) CacheDir() string {
+	return filepath.Join(e.home, "cache")
+}
+
+// SaveConfig persists the current config back to the active profile.
+func (e *Engine) SaveConfig() error {
+	return e.Profiles.Save(e.Config)
+}
+
+// GitHubClient builds a github.Client authenticated as the active account,
+// if any. Commands that don't need GitHub can ignore the returned client
+// being unauthenticated.
+func (e *Engine) GitHubClient() (*github.Client, error) {
+	token := ""
+	if e.Accounts.Active != "" {
+		t, err := e.Accounts.Token(e.Accounts.Active)
+		if err != nil {
+			return nil, err
+		}
+		token = t
+	}
+	timeout := e.NetworkTimeout
+	if timeout == 0 {
+		timeout, _ = time.ParseDuration(e.Config.NetworkTimeout)
+	}
+	return github.NewClient(token, e.CacheDir(), timeout, e.Config.HTTPProxy)
+}
@@ -0,0 +1,41 @@
+package core
+
+import (
+	"testing"
+	"time"
+)
+
+func TestGitHubClientPrefersInvocationTimeoutOverConfig(t *testing.T) {
+	e := &Engine{
+		Config:         DefaultConfig(),
+		Accounts:       &AccountStore{},
+		NetworkTimeout: 5 * time.Second,
+		home:           t.TempDir(),
+	}
+	e.Config.NetworkTimeout = "30s"
+
+	client, err := e.GitHubClient()
+	if err != nil {
+		t.Fatalf("GitHubClient: %v", err)
+	}
+	if client.HTTPClient.Timeout != 5*time.Second {
+		t.Errorf("HTTPClient.Timeout = %v, want the invocation override of 5s", client.HTTPClient.Timeout)
+	}
+}
+
+func TestGitHubClientFallsBackToConfigTimeout(t *testing.T) {
+	e := &Engine{
+		Config:   DefaultConfig(),
+		Accounts: &AccountStore{},
+		home:     t.TempDir(),
+	}
+	e.Config.NetworkTimeout = "30s"
+
+	client, err := e.GitHubClient()
+	if err != nil {
+		t.Fatalf("GitHubClient: %v", err)
+	}
+	if client.HTTPClient.Timeout != 30*time.Second {
+		t.Errorf("HTTPClient.Timeout = %v, want the config default of 30s", client.HTTPClient.Timeout)
+	}
+}
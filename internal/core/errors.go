@@ -0,0 +1,148 @@
+package core
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/buchorim/arngit/internal/github"
+)
+
+// AppError pairs an error with an actionable hint, so command output can
+// tell the user what to do about it instead of just printing a bare
+// message.
+type AppError struct {
+	Err  error
+	Hint string
+}
+
+func (e *AppError) Error() string { return e.Err.Error() }
+func (e *AppError) Unwrap() error { return e.Err }
+
+// Sentinel errors that errorRegistry attaches hints to. Handlers can match
+// against these with errors.Is even after FromAPIError has wrapped one.
+var (
+	ErrAPIAuth      = errors.New("github: authentication failed")
+	ErrAPIRateLimit = errors.New("github: rate limit exceeded")
+	ErrAPINotFound  = errors.New("github: not found")
+
+	ErrGitAuth            = errors.New("git: authentication failed")
+	ErrGitConflict        = errors.New("git: merge conflict")
+	ErrGitNoChanges       = errors.New("git: nothing to commit")
+	ErrGitNonFastForward  = errors.New("git: non-fast-forward")
+	ErrGitNoRepo          = errors.New("not a git repository")
+	ErrGitBranchNotMerged = errors.New("git: branch not fully merged")
+	ErrGitNoTags          = errors.New("git: no tags to describe from")
+	ErrGitPullDiverged    = errors.New("git: branches diverged")
+
+	ErrNoAccount = errors.New("no GitHub account configured")
+)
+
+// errorRegistry maps each sentinel above to the hint FromAPIError/
+// FromGitError attaches when it maps a raw error onto that sentinel.
+var errorRegistry = map[error]string{
+	ErrAPIAuth:      "check that your saved account token is still valid (see `arngit account`)",
+	ErrAPIRateLimit: "you've hit GitHub's API rate limit; wait a while or authenticate to raise it",
+	ErrAPINotFound:  "double check the org/repo name and that your token can see it",
+
+	ErrGitAuth:            "check your git credentials (SSH key or credential helper) for this remote",
+	ErrGitConflict:        "resolve the conflicting files, then `arngit add` and `arngit commit`",
+	ErrGitNoChanges:       "there's nothing staged; `arngit add` the files you want to commit first",
+	ErrGitNonFastForward:  "pull the remote changes first, then push again",
+	ErrGitNoRepo:          "run this from inside a git repository, or `git init` one first",
+	ErrGitBranchNotMerged: "merge the branch first, or pass --force to delete it anyway",
+	ErrGitNoTags:          "create a tag first (e.g. `git tag v0.1.0`) before running describe",
+	ErrGitPullDiverged:    "the local and remote branches have diverged; pull without --ff-only to merge or rebase",
+
+	ErrNoAccount: "run `arngit account add` to save a GitHub account first",
+}
+
+// NewAppError promotes a plain sentinel error into an AppError, attaching
+// its registered hint (if any).
+func NewAppError(err error) *AppError {
+	return &AppError{Err: err, Hint: errorRegistry[err]}
+}
+
+// GetErrorHint returns the hint registered for err, following through an
+// *AppError's Hint field if err is one, or matching against errorRegistry's
+// sentinels otherwise. Returns "" if err carries no known hint.
+func GetErrorHint(err error) string {
+	var appErr *AppError
+	if errors.As(err, &appErr) {
+		return appErr.Hint
+	}
+	for sentinel, hint := range errorRegistry {
+		if errors.Is(err, sentinel) {
+			return hint
+		}
+	}
+	return ""
+}
+
+// classifyGitError inspects git stderr for the substrings git uses for a
+// handful of common failure modes, returning the matching sentinel above,
+// or nil if none match.
+func classifyGitError(stderr string) error {
+	switch {
+	case strings.Contains(stderr, "Authentication failed"):
+		return ErrGitAuth
+	case strings.Contains(stderr, "CONFLICT"):
+		return ErrGitConflict
+	case strings.Contains(stderr, "nothing to commit"):
+		return ErrGitNoChanges
+	case strings.Contains(stderr, "non-fast-forward"):
+		return ErrGitNonFastForward
+	case strings.Contains(stderr, "not fully merged"):
+		return ErrGitBranchNotMerged
+	case strings.Contains(stderr, "No names found, cannot describe"):
+		return ErrGitNoTags
+	case strings.Contains(stderr, "Not possible to fast-forward"):
+		return ErrGitPullDiverged
+	default:
+		return nil
+	}
+}
+
+// FromGitError wraps err in an AppError if its message matches one of
+// classifyGitError's known patterns, attaching the matching hint. Errors
+// that don't match (including ones already typed, e.g. by FromAPIError)
+// pass through unchanged.
+func FromGitError(err error) error {
+	if err == nil {
+		return nil
+	}
+	var appErr *AppError
+	if errors.As(err, &appErr) {
+		return err
+	}
+	sentinel := classifyGitError(err.Error())
+	if sentinel == nil {
+		return err
+	}
+	return &AppError{
+		Err:  fmt.Errorf("%w: %s", sentinel, err.Error()),
+		Hint: errorRegistry[sentinel],
+	}
+}
+
+// FromAPIError maps a *github.APIError's status code onto one of the
+// sentinel errors above, returning an AppError with the matching hint.
+// Status codes that don't match a known case pass err through unchanged,
+// with no hint.
+func FromAPIError(err *github.APIError) *AppError {
+	var sentinel error
+	switch {
+	case err.StatusCode == 401:
+		sentinel = ErrAPIAuth
+	case err.StatusCode == 403 && err.RateLimited:
+		sentinel = ErrAPIRateLimit
+	case err.StatusCode == 404:
+		sentinel = ErrAPINotFound
+	default:
+		return &AppError{Err: err}
+	}
+	return &AppError{
+		Err:  fmt.Errorf("%w: %s", sentinel, err.Message),
+		Hint: errorRegistry[sentinel],
+	}
+}
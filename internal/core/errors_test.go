@@ -0,0 +1,85 @@
+package core
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/buchorim/arngit/internal/github"
+)
+
+func TestFromAPIError(t *testing.T) {
+	tests := []struct {
+		name string
+		in   *github.APIError
+		want error
+		hint bool
+	}{
+		{"unauthorized", &github.APIError{StatusCode: 401}, ErrAPIAuth, true},
+		{"rate limited", &github.APIError{StatusCode: 403, RateLimited: true}, ErrAPIRateLimit, true},
+		{"forbidden not rate limited", &github.APIError{StatusCode: 403}, nil, false},
+		{"not found", &github.APIError{StatusCode: 404}, ErrAPINotFound, true},
+		{"server error", &github.APIError{StatusCode: 500}, nil, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := FromAPIError(tt.in)
+			if tt.want != nil {
+				if !errors.Is(got, tt.want) {
+					t.Errorf("FromAPIError(%+v) does not match sentinel %v", tt.in, tt.want)
+				}
+			}
+			if tt.hint && got.Hint == "" {
+				t.Errorf("FromAPIError(%+v) expected a hint, got none", tt.in)
+			}
+			if !tt.hint && got.Hint != "" {
+				t.Errorf("FromAPIError(%+v) expected no hint, got %q", tt.in, got.Hint)
+			}
+		})
+	}
+}
+
+func TestFromGitError(t *testing.T) {
+	tests := []struct {
+		name   string
+		stderr string
+		want   error
+	}{
+		{"auth", "git push: fatal: Authentication failed for 'https://github.com/x/y'", ErrGitAuth},
+		{"conflict", "git pull: CONFLICT (content): Merge conflict in file.txt", ErrGitConflict},
+		{"no changes", "git commit: nothing to commit, working tree clean", ErrGitNoChanges},
+		{"non-fast-forward", "git push: ! [rejected] main -> main (non-fast-forward)", ErrGitNonFastForward},
+		{"branch not merged", "git branch: error: the branch 'feature' is not fully merged", ErrGitBranchNotMerged},
+		{"no tags", "git describe: fatal: No names found, cannot describe anything.", ErrGitNoTags},
+		{"pull diverged", "git pull: fatal: Not possible to fast-forward, aborting.", ErrGitPullDiverged},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := FromGitError(fmt.Errorf("%s", tt.stderr))
+			if !errors.Is(got, tt.want) {
+				t.Errorf("FromGitError(%q) does not match sentinel %v", tt.stderr, tt.want)
+			}
+			if GetErrorHint(got) == "" {
+				t.Errorf("FromGitError(%q) expected a hint", tt.stderr)
+			}
+		})
+	}
+}
+
+func TestFromGitErrorUnrecognized(t *testing.T) {
+	err := fmt.Errorf("git status: exit status 1")
+	got := FromGitError(err)
+	if got != err {
+		t.Errorf("FromGitError(unrecognized) = %v, want it passed through unchanged", got)
+	}
+}
+
+func TestFromGitErrorPassesThroughAppError(t *testing.T) {
+	original := &AppError{Err: errors.New("already typed"), Hint: "existing hint"}
+	got := FromGitError(original)
+	if got != original {
+		t.Errorf("FromGitError(*AppError) = %v, want the same AppError unchanged", got)
+	}
+}
@@ -0,0 +1,40 @@
+package core
+
+import "sync"
+
+// Pool runs tasks with a bounded number of goroutines active at once, which
+// keeps multi-repo operations (clone-all, sync-all) from overwhelming the
+// network or the GitHub API rate limit.
+type Pool struct {
+	concurrency int
+}
+
+// NewPool returns a Pool that runs at most concurrency tasks at a time. A
+// non-positive concurrency is treated as 1.
+func NewPool(concurrency int) *Pool {
+	if concurrency < 1 {
+		concurrency = 1
+	}
+	return &Pool{concurrency: concurrency}
+}
+
+// Run executes each task, at most p.concurrency at a time, and returns their
+// errors in the same order as tasks. A nil entry means that task succeeded.
+func (p *Pool) Run(tasks []func() error) []error {
+	errs := make([]error, len(tasks))
+	sem := make(chan struct{}, p.concurrency)
+	var wg sync.WaitGroup
+
+	for i, task := range tasks {
+		wg.Add(1)
+		sem <- struct{}{}
+		go func(i int, task func() error) {
+			defer wg.Done()
+			defer func() { <-sem }()
+			errs[i] = task()
+		}(i, task)
+	}
+
+	wg.Wait()
+	return errs
+}
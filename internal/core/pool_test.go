@@ -0,0 +1,54 @@
+package core
+
+import (
+	"errors"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestPoolConcurrencyLimit(t *testing.T) {
+	const limit = 3
+	pool := NewPool(limit)
+
+	var current, max int32
+	tasks := make([]func() error, 10)
+	for i := range tasks {
+		tasks[i] = func() error {
+			n := atomic.AddInt32(&current, 1)
+			for {
+				m := atomic.LoadInt32(&max)
+				if n <= m || atomic.CompareAndSwapInt32(&max, m, n) {
+					break
+				}
+			}
+			time.Sleep(5 * time.Millisecond)
+			atomic.AddInt32(&current, -1)
+			return nil
+		}
+	}
+
+	pool.Run(tasks)
+
+	if max > limit {
+		t.Errorf("observed %d concurrent tasks, want at most %d", max, limit)
+	}
+}
+
+func TestPoolErrorCollection(t *testing.T) {
+	pool := NewPool(2)
+	boom := errors.New("boom")
+
+	errs := pool.Run([]func() error{
+		func() error { return nil },
+		func() error { return boom },
+		func() error { return nil },
+	})
+
+	if errs[0] != nil || errs[2] != nil {
+		t.Errorf("expected successful tasks to report nil error, got %v", errs)
+	}
+	if errs[1] != boom {
+		t.Errorf("errs[1] = %v, want %v", errs[1], boom)
+	}
+}
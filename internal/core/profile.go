@@ -0,0 +1,135 @@
+package core
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+)
+
+// defaultProfile is the name every install starts with.
+const defaultProfile = "default"
+
+// ProfileManager stores multiple named Config sets as separate YAML files
+// under ~/.arngit/profiles, with a small pointer file recording which one
+// is active. This lets a user keep e.g. "work" and "oss" configs (default
+// account, theme, git behavior) and switch between them as a set.
+type ProfileManager struct {
+	dir        string
+	activePath string
+}
+
+// NewProfileManager roots a ProfileManager at ~/.arngit, creating the
+// profiles directory and a "default" profile if neither exists yet.
+func NewProfileManager(root string) (*ProfileManager, error) {
+	dir := filepath.Join(root, "profiles")
+	if err := os.MkdirAll(dir, 0o700); err != nil {
+		return nil, err
+	}
+
+	pm := &ProfileManager{
+		dir:        dir,
+		activePath: filepath.Join(root, "active_profile"),
+	}
+
+	if _, err := os.Stat(pm.configPath(defaultProfile)); os.IsNotExist(err) {
+		if err := pm.Create(defaultProfile); err != nil {
+			return nil, err
+		}
+	}
+
+	if _, err := os.Stat(pm.activePath); os.IsNotExist(err) {
+		if err := pm.SetActive(defaultProfile); err != nil {
+			return nil, err
+		}
+	}
+
+	return pm, nil
+}
+
+func (pm *ProfileManager) configPath(name string) string {
+	return filepath.Join(pm.dir, name+".yaml")
+}
+
+// Active returns the name of the currently active profile.
+func (pm *ProfileManager) Active() (string, error) {
+	data, err := os.ReadFile(pm.activePath)
+	if os.IsNotExist(err) {
+		return defaultProfile, nil
+	}
+	if err != nil {
+		return "", err
+	}
+	name := strings.TrimSpace(string(data))
+	if name == "" {
+		return defaultProfile, nil
+	}
+	return name, nil
+}
+
+// SetActive points the active-profile pointer at name. It fails if the
+// profile hasn't been created yet.
+func (pm *ProfileManager) SetActive(name string) error {
+	if _, err := os.Stat(pm.configPath(name)); err != nil {
+		return fmt.Errorf("profile %q does not exist", name)
+	}
+	return os.WriteFile(pm.activePath, []byte(name), 0o644)
+}
+
+// Create writes a fresh default-valued profile named name. It is a no-op
+// error if the profile already exists.
+func (pm *ProfileManager) Create(name string) error {
+	path := pm.configPath(name)
+	if _, err := os.Stat(path); err == nil {
+		return fmt.Errorf("profile %q already exists", name)
+	}
+	return DefaultConfig().Save(path)
+}
+
+// ActiveConfigPath returns the file path backing the currently active
+// profile, for callers that need to read or rewrite it directly (config
+// reset's backup, config edit).
+func (pm *ProfileManager) ActiveConfigPath() (string, error) {
+	active, err := pm.Active()
+	if err != nil {
+		return "", err
+	}
+	return pm.configPath(active), nil
+}
+
+// List returns the names of all known profiles, sorted alphabetically.
+func (pm *ProfileManager) List() ([]string, error) {
+	entries, err := os.ReadDir(pm.dir)
+	if err != nil {
+		return nil, err
+	}
+
+	var names []string
+	for _, e := range entries {
+		if e.IsDir() || !strings.HasSuffix(e.Name(), ".yaml") {
+			continue
+		}
+		names = append(names, strings.TrimSuffix(e.Name(), ".yaml"))
+	}
+	sort.Strings(names)
+	return names, nil
+}
+
+// Load reads the Config for the active profile.
+func (pm *ProfileManager) Load() (*Config, error) {
+	active, err := pm.Active()
+	if err != nil {
+		return nil, err
+	}
+	return LoadConfig(pm.configPath(active))
+}
+
+// Save writes cfg back to the active profile's file.
+func (pm *ProfileManager) Save(cfg *Config) error {
+	active, err := pm.Active()
+	if err != nil {
+		return err
+	}
+	return cfg.Save(pm.configPath(active))
+}
@@ -0,0 +1,91 @@
+package core
+
+import (
+	"testing"
+)
+
+func TestProfileManagerCreateAndSwitch(t *testing.T) {
+	root := t.TempDir()
+
+	pm, err := NewProfileManager(root)
+	if err != nil {
+		t.Fatalf("NewProfileManager: %v", err)
+	}
+
+	active, err := pm.Active()
+	if err != nil {
+		t.Fatalf("Active: %v", err)
+	}
+	if active != defaultProfile {
+		t.Fatalf("Active() = %q, want %q", active, defaultProfile)
+	}
+
+	if err := pm.Create("work"); err != nil {
+		t.Fatalf("Create(work): %v", err)
+	}
+
+	names, err := pm.List()
+	if err != nil {
+		t.Fatalf("List: %v", err)
+	}
+	if len(names) != 2 || names[0] != defaultProfile || names[1] != "work" {
+		t.Fatalf("List() = %v, want [default work]", names)
+	}
+
+	if err := pm.SetActive("work"); err != nil {
+		t.Fatalf("SetActive(work): %v", err)
+	}
+	active, err = pm.Active()
+	if err != nil {
+		t.Fatalf("Active: %v", err)
+	}
+	if active != "work" {
+		t.Fatalf("Active() = %q, want work", active)
+	}
+}
+
+func TestProfileManagerLoadsActiveValues(t *testing.T) {
+	root := t.TempDir()
+
+	pm, err := NewProfileManager(root)
+	if err != nil {
+		t.Fatalf("NewProfileManager: %v", err)
+	}
+	if err := pm.Create("work"); err != nil {
+		t.Fatalf("Create(work): %v", err)
+	}
+
+	cfg, err := LoadConfig(pm.configPath("work"))
+	if err != nil {
+		t.Fatalf("LoadConfig: %v", err)
+	}
+	cfg.Theme = "dark"
+	if err := cfg.Save(pm.configPath("work")); err != nil {
+		t.Fatalf("Save: %v", err)
+	}
+
+	if err := pm.SetActive("work"); err != nil {
+		t.Fatalf("SetActive: %v", err)
+	}
+
+	loaded, err := pm.Load()
+	if err != nil {
+		t.Fatalf("Load: %v", err)
+	}
+	if loaded.Theme != "dark" {
+		t.Fatalf("Load().Theme = %q, want dark", loaded.Theme)
+	}
+}
+
+func TestProfileManagerSetActiveUnknownProfile(t *testing.T) {
+	root := t.TempDir()
+
+	pm, err := NewProfileManager(root)
+	if err != nil {
+		t.Fatalf("NewProfileManager: %v", err)
+	}
+
+	if err := pm.SetActive("does-not-exist"); err == nil {
+		t.Fatal("SetActive(does-not-exist) = nil, want error")
+	}
+}
@@ -0,0 +1,70 @@
+package core
+
+import (
+	"encoding/json"
+	"os"
+)
+
+// ProtectedList tracks repository paths that require explicit confirmation
+// before a destructive or network-mutating operation (push, delete) runs.
+type ProtectedList struct {
+	Paths []string `json:"paths"`
+
+	path string
+}
+
+// LoadProtectedList reads the protected-repo list at path. A missing file
+// yields an empty list.
+func LoadProtectedList(path string) (*ProtectedList, error) {
+	list := &ProtectedList{path: path}
+
+	data, err := os.ReadFile(path)
+	if os.IsNotExist(err) {
+		return list, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	if err := json.Unmarshal(data, list); err != nil {
+		return nil, err
+	}
+	list.path = path
+	return list, nil
+}
+
+// Save writes the list back to disk.
+func (l *ProtectedList) Save() error {
+	data, err := json.MarshalIndent(l, "", "  ")
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(l.path, data, 0o644)
+}
+
+// Is reports whether repoPath is protected.
+func (l *ProtectedList) Is(repoPath string) bool {
+	for _, p := range l.Paths {
+		if p == repoPath {
+			return true
+		}
+	}
+	return false
+}
+
+// Protect adds repoPath to the list if it isn't already present.
+func (l *ProtectedList) Protect(repoPath string) {
+	if l.Is(repoPath) {
+		return
+	}
+	l.Paths = append(l.Paths, repoPath)
+}
+
+// Unprotect removes repoPath from the list.
+func (l *ProtectedList) Unprotect(repoPath string) {
+	for i, p := range l.Paths {
+		if p == repoPath {
+			l.Paths = append(l.Paths[:i], l.Paths[i+1:]...)
+			return
+		}
+	}
+}
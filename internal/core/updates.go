@@ -0,0 +1,94 @@
+package core
+
+import (
+	"context"
+	"fmt"
+	"path/filepath"
+	"time"
+
+	"github.com/buchorim/arngit/internal/update"
+)
+
+// updateCheckDelay is how long CheckUpdatesBackground waits before making
+// its first network call, so a one-shot command that never calls it pays
+// nothing, and a long-lived one (the interactive REPL) doesn't hit the
+// network on every keystroke-fast startup.
+const updateCheckDelay = 5 * time.Second
+
+// CheckUpdatesBackground starts a goroutine that, after a short delay,
+// checks for a newer arngit release and logs the result. It's meant to be
+// called only from long-lived invocations (the interactive REPL, the
+// dashboard); one-shot commands should skip it entirely rather than hold
+// its goroutine open past their own exit.
+//
+// The goroutine is tied to a context canceled by Close, so it never
+// outlives the Engine: calling Close before the delay elapses cancels the
+// check before it ever touches the network.
+func (e *Engine) CheckUpdatesBackground() {
+	ctx, cancel := context.WithCancel(context.Background())
+	e.updateCancel = cancel
+	e.updateDone = make(chan struct{})
+
+	go func() {
+		defer close(e.updateDone)
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(updateCheckDelay):
+		}
+
+		e.runUpdateCheck()
+	}()
+}
+
+// Close releases resources the Engine holds open across the process
+// lifetime. Currently that's just the background update checker's
+// goroutine, if CheckUpdatesBackground started one.
+func (e *Engine) Close() error {
+	if e.updateCancel != nil {
+		e.updateCancel()
+	}
+	return nil
+}
+
+// runUpdateCheck performs the actual release lookup and logs the outcome.
+// Failures are logged rather than surfaced, since an update check should
+// never be the reason a command fails.
+func (e *Engine) runUpdateCheck() {
+	token := ""
+	if e.Accounts.Active != "" {
+		if t, err := e.Accounts.Token(e.Accounts.Active); err == nil {
+			token = t
+		}
+	}
+
+	timeout, _ := time.ParseDuration(e.Config.NetworkTimeout)
+	mgr, err := update.NewManager(e.Version, token, timeout, e.Config.HTTPProxy)
+	if err != nil {
+		e.Logger.Error("update check: " + err.Error())
+		return
+	}
+	if e.Config.UpdateChannel != "" {
+		mgr.Channel = e.Config.UpdateChannel
+	}
+	mgr.LastCheckPath = filepath.Join(e.CacheDir(), "last_update_check")
+
+	interval, _ := time.ParseDuration(e.Config.UpdateInterval)
+	if !mgr.ShouldCheck(interval) {
+		return
+	}
+
+	release, err := mgr.LatestRelease()
+	if err != nil {
+		e.Logger.Error("update check: " + err.Error())
+		return
+	}
+	if err := mgr.RecordCheck(); err != nil {
+		e.Logger.Error("update check: recording last-check time: " + err.Error())
+	}
+
+	if release.TagName != "" && release.TagName != e.Version {
+		e.Logger.Info(fmt.Sprintf("update available: %s -> %s", e.Version, release.TagName))
+	}
+}
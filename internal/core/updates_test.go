@@ -0,0 +1,25 @@
+package core
+
+import (
+	"testing"
+	"time"
+)
+
+func TestCloseStopsBackgroundUpdateCheckPromptly(t *testing.T) {
+	e := &Engine{
+		Config:   DefaultConfig(),
+		Accounts: &AccountStore{},
+		Logger:   nil,
+	}
+
+	e.CheckUpdatesBackground()
+	if err := e.Close(); err != nil {
+		t.Fatalf("Close: %v", err)
+	}
+
+	select {
+	case <-e.updateDone:
+	case <-time.After(time.Second):
+		t.Fatal("background update check did not stop promptly after Close")
+	}
+}
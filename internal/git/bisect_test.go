@@ -0,0 +1,34 @@
+package git
+
+import "testing"
+
+func TestParseBisectResult(t *testing.T) {
+	tests := []struct {
+		name   string
+		output string
+		hash   string
+		ok     bool
+	}{
+		{
+			name:   "found",
+			output: "Bisecting: 0 revisions left to test after this (roughly 0 steps)\nabc1234 is the first bad commit\ncommit abc1234\n",
+			hash:   "abc1234",
+			ok:     true,
+		},
+		{
+			name:   "not found",
+			output: "Bisecting: 3 revisions left to test after this (roughly 2 steps)\n",
+			hash:   "",
+			ok:     false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			hash, ok := ParseBisectResult(tt.output)
+			if hash != tt.hash || ok != tt.ok {
+				t.Errorf("ParseBisectResult(%q) = (%q, %v), want (%q, %v)", tt.output, hash, ok, tt.hash, tt.ok)
+			}
+		})
+	}
+}
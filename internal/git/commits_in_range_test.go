@@ -0,0 +1,35 @@
+package git
+
+import "testing"
+
+func TestCommitsInRangeReturnsSubjectAndBody(t *testing.T) {
+	dir := setupConflictingRepo(t)
+	svc := NewService(dir)
+
+	commits, err := svc.CommitsInRange("main", "feature")
+	if err != nil {
+		t.Fatalf("CommitsInRange: %v", err)
+	}
+	if len(commits) != 1 {
+		t.Fatalf("commits = %v, want 1 entry", commits)
+	}
+	if commits[0].Subject != "feature change" {
+		t.Errorf("subject = %q, want %q", commits[0].Subject, "feature change")
+	}
+	if commits[0].Body != "" {
+		t.Errorf("body = %q, want empty", commits[0].Body)
+	}
+}
+
+func TestCommitsInRangeIdenticalRefs(t *testing.T) {
+	dir := setupConflictingRepo(t)
+	svc := NewService(dir)
+
+	commits, err := svc.CommitsInRange("main", "main")
+	if err != nil {
+		t.Fatalf("CommitsInRange: %v", err)
+	}
+	if len(commits) != 0 {
+		t.Fatalf("commits = %v, want none", commits)
+	}
+}
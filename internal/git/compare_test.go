@@ -0,0 +1,32 @@
+package git
+
+import "testing"
+
+func TestCompareRefsCountsAndLists(t *testing.T) {
+	dir := setupConflictingRepo(t)
+	svc := NewService(dir)
+
+	ahead, behind, commits, err := svc.CompareRefs("main", "feature")
+	if err != nil {
+		t.Fatalf("CompareRefs: %v", err)
+	}
+	if ahead != 1 || behind != 1 {
+		t.Fatalf("ahead = %d, behind = %d, want 1, 1", ahead, behind)
+	}
+	if len(commits) != 1 {
+		t.Fatalf("commits = %v, want 1 entry", commits)
+	}
+}
+
+func TestCompareRefsIdenticalRefs(t *testing.T) {
+	dir := setupConflictingRepo(t)
+	svc := NewService(dir)
+
+	ahead, behind, commits, err := svc.CompareRefs("main", "main")
+	if err != nil {
+		t.Fatalf("CompareRefs: %v", err)
+	}
+	if ahead != 0 || behind != 0 || len(commits) != 0 {
+		t.Fatalf("ahead = %d, behind = %d, commits = %v", ahead, behind, commits)
+	}
+}
@@ -0,0 +1,92 @@
+package git
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+)
+
+// JournalEntry records one mutating command arngit ran against a repo, so
+// undo and forensics have enough information to reverse or explain it.
+type JournalEntry struct {
+	Time    time.Time `json:"time"`
+	Command string    `json:"command"`
+	Args    []string  `json:"args,omitempty"`
+	Head    string    `json:"head,omitempty"`
+}
+
+// journalPath returns the repo's journal file, under .git/arngit so it
+// travels with the checkout but never gets committed into the repo's own
+// history.
+func (s *Service) journalPath() (string, error) {
+	dir, err := s.gitDir()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(dir, "arngit", "journal.jsonl"), nil
+}
+
+// AppendJournal records a mutating command against this repo.
+func (s *Service) AppendJournal(entry JournalEntry) error {
+	path, err := s.journalPath()
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(filepath.Dir(path), 0o700); err != nil {
+		return err
+	}
+	f, err := os.OpenFile(path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0o600)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	data, err := json.Marshal(entry)
+	if err != nil {
+		return err
+	}
+	_, err = f.Write(append(data, '\n'))
+	return err
+}
+
+// ReadJournal returns this repo's journal entries in the order they were
+// recorded, or nil if nothing has been journaled yet.
+func (s *Service) ReadJournal() ([]JournalEntry, error) {
+	path, err := s.journalPath()
+	if err != nil {
+		return nil, err
+	}
+	data, err := os.ReadFile(path)
+	if os.IsNotExist(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	var entries []JournalEntry
+	for _, line := range strings.Split(string(data), "\n") {
+		line = strings.TrimSpace(line)
+		if line == "" {
+			continue
+		}
+		var entry JournalEntry
+		if err := json.Unmarshal([]byte(line), &entry); err != nil {
+			return nil, err
+		}
+		entries = append(entries, entry)
+	}
+	return entries, nil
+}
+
+// LastJournalEntry returns the most recently recorded entry, or ok=false if
+// nothing has been journaled yet.
+func (s *Service) LastJournalEntry() (entry JournalEntry, ok bool, err error) {
+	entries, err := s.ReadJournal()
+	if err != nil || len(entries) == 0 {
+		return JournalEntry{}, false, err
+	}
+	return entries[len(entries)-1], true, nil
+}
@@ -0,0 +1,70 @@
+package git
+
+import (
+	"os/exec"
+	"path/filepath"
+	"testing"
+)
+
+func setupJournalRepo(t *testing.T) *Service {
+	t.Helper()
+	if _, err := exec.LookPath("git"); err != nil {
+		t.Skip("git not installed")
+	}
+
+	dir := t.TempDir()
+	if out, err := exec.Command("git", "init", "-q", "-b", "main", dir).CombinedOutput(); err != nil {
+		t.Fatalf("git init: %v: %s", err, out)
+	}
+	return NewService(dir)
+}
+
+func TestAppendAndReadJournal(t *testing.T) {
+	svc := setupJournalRepo(t)
+
+	if entries, err := svc.ReadJournal(); err != nil || len(entries) != 0 {
+		t.Fatalf("ReadJournal() on a fresh repo = %v, %v, want no entries", entries, err)
+	}
+
+	if err := svc.AppendJournal(JournalEntry{Command: "add", Args: []string{"."}}); err != nil {
+		t.Fatalf("AppendJournal: %v", err)
+	}
+	if err := svc.AppendJournal(JournalEntry{Command: "commit", Head: "abc123"}); err != nil {
+		t.Fatalf("AppendJournal: %v", err)
+	}
+
+	entries, err := svc.ReadJournal()
+	if err != nil {
+		t.Fatalf("ReadJournal: %v", err)
+	}
+	if len(entries) != 2 {
+		t.Fatalf("ReadJournal() = %d entries, want 2", len(entries))
+	}
+	if entries[0].Command != "add" || entries[1].Command != "commit" {
+		t.Fatalf("ReadJournal() = %+v, want add then commit", entries)
+	}
+
+	last, ok, err := svc.LastJournalEntry()
+	if err != nil || !ok {
+		t.Fatalf("LastJournalEntry() = %+v, %v, %v", last, ok, err)
+	}
+	if last.Command != "commit" || last.Head != "abc123" {
+		t.Fatalf("LastJournalEntry() = %+v, want the commit entry", last)
+	}
+}
+
+func TestJournalStoredUnderGitDir(t *testing.T) {
+	svc := setupJournalRepo(t)
+	if err := svc.AppendJournal(JournalEntry{Command: "add"}); err != nil {
+		t.Fatalf("AppendJournal: %v", err)
+	}
+
+	path, err := svc.journalPath()
+	if err != nil {
+		t.Fatalf("journalPath: %v", err)
+	}
+	want := filepath.Join(svc.Dir, ".git", "arngit", "journal.jsonl")
+	if path != want {
+		t.Fatalf("journalPath() = %q, want %q", path, want)
+	}
+}
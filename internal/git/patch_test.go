@@ -0,0 +1,89 @@
+package git
+
+import (
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func setupPatchTestRepo(t *testing.T) (dir string, patchPath string) {
+	t.Helper()
+	if _, err := exec.LookPath("git"); err != nil {
+		t.Skip("git not installed")
+	}
+
+	dir = t.TempDir()
+	run := func(args ...string) string {
+		cmd := exec.Command("git", args...)
+		cmd.Dir = dir
+		out, err := cmd.CombinedOutput()
+		if err != nil {
+			t.Fatalf("git %v: %v: %s", args, err, out)
+		}
+		return string(out)
+	}
+	run("init", "-q")
+	run("config", "user.email", "test@example.com")
+	run("config", "user.name", "test")
+	if err := os.WriteFile(filepath.Join(dir, "f.txt"), []byte("one\ntwo\nthree\n"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	run("add", ".")
+	run("commit", "-q", "-m", "init")
+
+	if err := os.WriteFile(filepath.Join(dir, "f.txt"), []byte("one\ntwo\nTHREE\n"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	patch := run("diff")
+
+	patchPath = filepath.Join(t.TempDir(), "change.patch")
+	if err := os.WriteFile(patchPath, []byte(patch), 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	run("checkout", "--", "f.txt")
+	return dir, patchPath
+}
+
+func TestApplyPatchCheckSucceedsForGoodPatch(t *testing.T) {
+	dir, patchPath := setupPatchTestRepo(t)
+	svc := NewService(dir)
+
+	if _, err := svc.ApplyPatch(patchPath, true); err != nil {
+		t.Fatalf("ApplyPatch(check) = %v, want nil for a clean patch", err)
+	}
+}
+
+func TestApplyPatchAppliesChanges(t *testing.T) {
+	dir, patchPath := setupPatchTestRepo(t)
+	svc := NewService(dir)
+
+	if _, err := svc.ApplyPatch(patchPath, false); err != nil {
+		t.Fatalf("ApplyPatch = %v", err)
+	}
+
+	content, err := os.ReadFile(filepath.Join(dir, "f.txt"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.Contains(string(content), "THREE") {
+		t.Errorf("f.txt = %q, want it to contain the patched line", content)
+	}
+}
+
+func TestApplyPatchRejectsBadPatch(t *testing.T) {
+	dir, _ := setupPatchTestRepo(t)
+	svc := NewService(dir)
+
+	badPatchPath := filepath.Join(t.TempDir(), "bad.patch")
+	bad := "--- a/nonexistent.txt\n+++ b/nonexistent.txt\n@@ -1,1 +1,1 @@\n-old\n+new\n"
+	if err := os.WriteFile(badPatchPath, []byte(bad), 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := svc.ApplyPatch(badPatchPath, true); err == nil {
+		t.Fatal("expected ApplyPatch(check) to fail for a patch targeting a nonexistent file")
+	}
+}
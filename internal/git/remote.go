@@ -0,0 +1,39 @@
+package git
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+// urlRemotePattern matches proper URL forms: https://, http://, ssh://, and
+// git://, e.g. "https://github.com/owner/repo.git" or
+// "ssh://git@github.com/owner/repo.git".
+var urlRemotePattern = regexp.MustCompile(`^(?:https?|ssh|git)://(?:[^@/]+@)?([^/]+)/(.+?)(?:\.git)?/?$`)
+
+// scpLikeRemotePattern matches git's traditional scp-like syntax, e.g.
+// "git@github.com:owner/repo.git".
+var scpLikeRemotePattern = regexp.MustCompile(`^(?:[^@]+@)?([^:/]+):(.+?)(?:\.git)?/?$`)
+
+// ParseRemoteURL extracts the host, owner, and repo name from a git remote
+// URL, accepting HTTPS, ssh://, and scp-like ("git@host:owner/repo") forms
+// from any host, not just github.com.
+func ParseRemoteURL(url string) (host, owner, repo string, err error) {
+	var rest string
+	switch {
+	case urlRemotePattern.MatchString(url):
+		m := urlRemotePattern.FindStringSubmatch(url)
+		host, rest = m[1], m[2]
+	case scpLikeRemotePattern.MatchString(url):
+		m := scpLikeRemotePattern.FindStringSubmatch(url)
+		host, rest = m[1], m[2]
+	default:
+		return "", "", "", fmt.Errorf("unrecognized remote URL: %s", url)
+	}
+
+	owner, repo, ok := strings.Cut(rest, "/")
+	if !ok || owner == "" || repo == "" {
+		return "", "", "", fmt.Errorf("remote URL missing owner/repo: %s", url)
+	}
+	return host, owner, repo, nil
+}
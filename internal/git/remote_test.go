@@ -0,0 +1,40 @@
+package git
+
+import "testing"
+
+func TestParseRemoteURL(t *testing.T) {
+	tests := []struct {
+		name  string
+		url   string
+		host  string
+		owner string
+		repo  string
+	}{
+		{"scp-like with .git", "git@github.com:owner/repo.git", "github.com", "owner", "repo"},
+		{"scp-like without .git", "git@github.com:owner/repo", "github.com", "owner", "repo"},
+		{"https with .git", "https://github.com/owner/repo.git", "github.com", "owner", "repo"},
+		{"https without .git", "https://github.com/owner/repo", "github.com", "owner", "repo"},
+		{"ssh scheme", "ssh://git@github.com/owner/repo.git", "github.com", "owner", "repo"},
+		{"non-github host", "https://gitlab.example.com/owner/repo.git", "gitlab.example.com", "owner", "repo"},
+		{"scp-like non-github host", "git@gitlab.example.com:owner/repo.git", "gitlab.example.com", "owner", "repo"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			host, owner, repo, err := ParseRemoteURL(tt.url)
+			if err != nil {
+				t.Fatalf("ParseRemoteURL(%q) error: %v", tt.url, err)
+			}
+			if host != tt.host || owner != tt.owner || repo != tt.repo {
+				t.Errorf("ParseRemoteURL(%q) = (%q, %q, %q), want (%q, %q, %q)",
+					tt.url, host, owner, repo, tt.host, tt.owner, tt.repo)
+			}
+		})
+	}
+}
+
+func TestParseRemoteURLInvalid(t *testing.T) {
+	if _, _, _, err := ParseRemoteURL("not a url"); err == nil {
+		t.Error("expected error for unrecognized remote URL")
+	}
+}
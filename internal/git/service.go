@@ -0,0 +1,938 @@
+// Package git wraps the git CLI behind a small, testable Service so that
+// command handlers never shell out directly.
+package git
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/buchorim/arngit/internal/logging"
+)
+
+// Service runs git commands against a working directory.
+type Service struct {
+	Dir string
+
+	// Verbose, when set, causes every executed git command to be echoed
+	// to Echo (or os.Stderr, if Echo is nil) before it runs.
+	Verbose bool
+	Echo    io.Writer
+
+	// Ctx, if set, is the parent context every git invocation runs under
+	// (e.g. so Ctrl+C can cancel a hung command). A nil Ctx behaves like
+	// context.Background().
+	Ctx context.Context
+
+	// Timeout bounds how long any single invocation may run. Zero means
+	// no additional bound beyond Ctx.
+	Timeout time.Duration
+
+	// NetworkTimeout, if set, overrides Timeout specifically for commands
+	// that talk to a remote (push, pull, fetch, clone).
+	NetworkTimeout time.Duration
+
+	// ProxyURL, if set, is exported as HTTPS_PROXY/HTTP_PROXY to network
+	// commands, overriding whatever the environment already has. Empty
+	// leaves the inherited environment (and its own proxy vars) alone.
+	ProxyURL string
+}
+
+// NewService returns a Service rooted at dir.
+func NewService(dir string) *Service {
+	return &Service{Dir: dir}
+}
+
+// echoWriter returns where verbose command echoes go, defaulting to stderr
+// so they don't pollute piped stdout.
+func (s *Service) echoWriter() io.Writer {
+	if s.Echo != nil {
+		return s.Echo
+	}
+	return os.Stderr
+}
+
+// context builds the context a single invocation should run under,
+// combining s.Ctx with s.Timeout.
+func (s *Service) context() (context.Context, context.CancelFunc) {
+	parent := s.Ctx
+	if parent == nil {
+		parent = context.Background()
+	}
+	if s.Timeout > 0 {
+		return context.WithTimeout(parent, s.Timeout)
+	}
+	return context.WithCancel(parent)
+}
+
+// run executes `git <args>` in s.Dir and returns trimmed stdout. Non-zero
+// exits return an error containing stderr.
+func (s *Service) run(args ...string) (string, error) {
+	return s.exec("", args...)
+}
+
+// runNetwork is run, but applies NetworkTimeout in place of Timeout and
+// ProxyURL as an environment override, for commands that talk to a remote.
+func (s *Service) runNetwork(args ...string) (string, error) {
+	if s.NetworkTimeout <= 0 && s.ProxyURL == "" {
+		return s.run(args...)
+	}
+	original := s.Timeout
+	if s.NetworkTimeout > 0 {
+		s.Timeout = s.NetworkTimeout
+	}
+	defer func() { s.Timeout = original }()
+
+	if s.ProxyURL == "" {
+		return s.run(args...)
+	}
+	return s.execEnv([]string{"HTTPS_PROXY=" + s.ProxyURL, "HTTP_PROXY=" + s.ProxyURL}, "", args...)
+}
+
+// runWithStdin is run, but feeds input to the command's stdin. Used by
+// commands like `cat-file --batch-check` that read object names from stdin.
+func (s *Service) runWithStdin(input string, args ...string) (string, error) {
+	return s.exec(input, args...)
+}
+
+// exec is the shared, context-aware implementation behind run and
+// runWithStdin. stdin == "" means don't attach a stdin pipe at all.
+func (s *Service) exec(stdin string, args ...string) (string, error) {
+	return s.execEnv(nil, stdin, args...)
+}
+
+// execEnv is exec with extra environment variables appended on top of the
+// inherited environment (later entries win on lookup).
+func (s *Service) execEnv(extraEnv []string, stdin string, args ...string) (string, error) {
+	if s.Verbose {
+		fmt.Fprintf(s.echoWriter(), "+ git %s\n", logging.Redact(strings.Join(args, " ")))
+	}
+
+	ctx, cancel := s.context()
+	defer cancel()
+
+	cmd := exec.CommandContext(ctx, "git", args...)
+	cmd.Dir = s.Dir
+	if len(extraEnv) > 0 {
+		cmd.Env = append(os.Environ(), extraEnv...)
+	}
+	if stdin != "" {
+		cmd.Stdin = strings.NewReader(stdin)
+	}
+
+	var stdout, stderr bytes.Buffer
+	cmd.Stdout = &stdout
+	cmd.Stderr = &stderr
+
+	if err := cmd.Run(); err != nil {
+		if errors.Is(ctx.Err(), context.DeadlineExceeded) {
+			return "", fmt.Errorf("git %s: timed out after %s", logging.Redact(strings.Join(args, " ")), s.Timeout)
+		}
+		if errors.Is(ctx.Err(), context.Canceled) {
+			return "", fmt.Errorf("git %s: cancelled", logging.Redact(strings.Join(args, " ")))
+		}
+		msg := strings.TrimSpace(stderr.String())
+		if msg == "" {
+			msg = err.Error()
+		}
+		return "", fmt.Errorf("git %s: %s", logging.Redact(strings.Join(args, " ")), logging.Redact(msg))
+	}
+
+	return strings.TrimSpace(stdout.String()), nil
+}
+
+// RevListObjects lists every object reachable from any ref, one per line as
+// "<hash>" or "<hash> <path>" (blobs referenced by a tree include their
+// path; commits and unnamed objects don't).
+func (s *Service) RevListObjects() (string, error) {
+	return s.run("rev-list", "--objects", "--all")
+}
+
+// CatFileBatchCheck runs `git cat-file --batch-check` over hashes, returning
+// one "<hash> <type> <size>" line per input hash.
+func (s *Service) CatFileBatchCheck(hashes []string) (string, error) {
+	return s.runWithStdin(strings.Join(hashes, "\n"), "cat-file", "--batch-check")
+}
+
+// RevListCount returns the number of commits in revRange (e.g.
+// "old..new"), as reported by `git rev-list --count`.
+func (s *Service) RevListCount(revRange string) (int, error) {
+	out, err := s.run("rev-list", "--count", revRange)
+	if err != nil {
+		return 0, err
+	}
+	n, err := strconv.Atoi(strings.TrimSpace(out))
+	if err != nil {
+		return 0, fmt.Errorf("parsing rev-list --count output %q: %w", out, err)
+	}
+	return n, nil
+}
+
+// IsRepo reports whether Dir is inside a git working tree.
+func (s *Service) IsRepo() bool {
+	_, err := s.run("rev-parse", "--git-dir")
+	return err == nil
+}
+
+// gitDir returns the absolute path to Dir's .git directory (resolving it
+// relative to Dir, since `rev-parse --git-dir` prints a relative path when
+// invoked from inside the worktree).
+func (s *Service) gitDir() (string, error) {
+	out, err := s.run("rev-parse", "--git-dir")
+	if err != nil {
+		return "", err
+	}
+	if filepath.IsAbs(out) {
+		return out, nil
+	}
+	return filepath.Join(s.Dir, out), nil
+}
+
+// RebaseInProgress reports whether an interactive or plain rebase is
+// currently paused on a conflict.
+func (s *Service) RebaseInProgress() bool {
+	dir, err := s.gitDir()
+	if err != nil {
+		return false
+	}
+	for _, name := range []string{"rebase-merge", "rebase-apply"} {
+		if _, err := os.Stat(filepath.Join(dir, name)); err == nil {
+			return true
+		}
+	}
+	return false
+}
+
+// MergeInProgress reports whether a merge is currently paused on a conflict.
+func (s *Service) MergeInProgress() bool {
+	dir, err := s.gitDir()
+	if err != nil {
+		return false
+	}
+	_, err = os.Stat(filepath.Join(dir, "MERGE_HEAD"))
+	return err == nil
+}
+
+// RebaseAbort runs `git rebase --abort`, restoring the branch to its
+// pre-rebase state.
+func (s *Service) RebaseAbort() (string, error) {
+	return s.run("rebase", "--abort")
+}
+
+// MergeAbort runs `git merge --abort`, restoring the branch to its
+// pre-merge state.
+func (s *Service) MergeAbort() (string, error) {
+	return s.run("merge", "--abort")
+}
+
+// Init creates a new repository in Dir with defaultBranch as its initial
+// branch. Git 2.28+ supports `-b` directly; older versions always init onto
+// "master", so Init falls back to repointing HEAD with `symbolic-ref`
+// afterward.
+func (s *Service) Init(defaultBranch string) (string, error) {
+	if defaultBranch == "" {
+		defaultBranch = "main"
+	}
+
+	out, err := s.run("init", "-b", defaultBranch)
+	if err == nil {
+		return out, nil
+	}
+
+	// Older git doesn't know -b; fall back to a plain init plus
+	// symbolic-ref, which works on every supported version.
+	out, err = s.run("init")
+	if err != nil {
+		return out, err
+	}
+	if _, refErr := s.run("symbolic-ref", "HEAD", "refs/heads/"+defaultBranch); refErr != nil {
+		return out, refErr
+	}
+	return out, nil
+}
+
+// Status returns `git status --porcelain` output.
+func (s *Service) Status() (string, error) {
+	return s.run("status", "--porcelain")
+}
+
+// Add stages the given paths.
+func (s *Service) Add(paths ...string) error {
+	args := append([]string{"add"}, paths...)
+	_, err := s.run(args...)
+	return err
+}
+
+// Commit creates a commit with the given message.
+func (s *Service) Commit(message string, opts CommitOptions) (string, error) {
+	args := []string{"commit"}
+	if opts.NoVerify {
+		args = append(args, "--no-verify")
+	}
+	if opts.All {
+		args = append(args, "-a")
+	}
+	switch {
+	case opts.Fixup != "":
+		args = append(args, "--fixup", opts.Fixup)
+	case opts.Squash != "":
+		args = append(args, "--squash", opts.Squash)
+	default:
+		args = append(args, "-m", message)
+	}
+	return s.run(args...)
+}
+
+// ResetSoft moves HEAD to rev without touching the index or working tree,
+// so any changes the moved-past commit(s) introduced stay staged.
+func (s *Service) ResetSoft(rev string) (string, error) {
+	return s.run("reset", "--soft", rev)
+}
+
+// ResetMixed moves HEAD to rev and resets the index to match it, leaving
+// the working tree untouched: changes that were staged become unstaged.
+func (s *Service) ResetMixed(rev string) (string, error) {
+	return s.run("reset", "--mixed", rev)
+}
+
+// FileStatus describes a staged file and its size on disk, as reported by
+// StagedFiles.
+type FileStatus struct {
+	Path string
+	Size int64
+}
+
+// StagedFiles lists the files currently staged for commit, with their
+// current working-tree size. Files staged for deletion (no longer present
+// on disk) are omitted, since there's nothing to size.
+func (s *Service) StagedFiles() ([]FileStatus, error) {
+	out, err := s.run("diff", "--cached", "--name-only")
+	if err != nil {
+		return nil, err
+	}
+
+	var files []FileStatus
+	for _, path := range strings.Split(strings.TrimSpace(out), "\n") {
+		if path == "" {
+			continue
+		}
+		info, err := os.Stat(filepath.Join(s.Dir, path))
+		if err != nil {
+			continue
+		}
+		files = append(files, FileStatus{Path: path, Size: info.Size()})
+	}
+	return files, nil
+}
+
+// TrackedIgnoredFiles lists tracked files that also match a .gitignore (or
+// other exclude-standard) pattern — files that were added before being
+// ignored, which stay tracked and confuse users into thinking they're
+// excluded.
+func (s *Service) TrackedIgnoredFiles() ([]string, error) {
+	out, err := s.run("ls-files", "-ci", "--exclude-standard")
+	if err != nil {
+		return nil, err
+	}
+	out = strings.TrimSpace(out)
+	if out == "" {
+		return nil, nil
+	}
+	return strings.Split(out, "\n"), nil
+}
+
+// UntrackFiles removes paths from the index without touching the working
+// tree (git rm --cached), for dropping files that shouldn't have been
+// tracked in the first place.
+func (s *Service) UntrackFiles(paths []string) (string, error) {
+	args := append([]string{"rm", "--cached"}, paths...)
+	return s.run(args...)
+}
+
+// CommitOptions configures a Commit invocation. Fixup and Squash are
+// mutually exclusive; when either is set it takes a commit-ish and message
+// is ignored, since git derives the commit message itself. NoVerify skips
+// pre-commit and commit-msg hooks.
+type CommitOptions struct {
+	Fixup    string
+	Squash   string
+	NoVerify bool
+
+	// All stages modified/deleted tracked files before committing (git
+	// commit -a), like Add would but without touching untracked files.
+	All bool
+}
+
+// ResolveRef verifies that ref names an existing commit, returning its full
+// hash if so, or an error if it doesn't resolve to one.
+func (s *Service) ResolveRef(ref string) (string, error) {
+	return s.run("rev-parse", "--verify", ref+"^{commit}")
+}
+
+// RebaseAutosquash runs an interactive rebase onto onto with --autosquash,
+// folding any --fixup/--squash commits into their targets. The sequence
+// editor is stubbed out so the generated plan is accepted non-interactively.
+func (s *Service) RebaseAutosquash(onto string) (string, error) {
+	return s.execEnv([]string{"GIT_SEQUENCE_EDITOR=true"}, "", "rebase", "-i", "--autosquash", onto)
+}
+
+// PushOptions configures a Push invocation.
+type PushOptions struct {
+	// Force pushes with --force, overwriting the remote branch.
+	Force bool
+	// SetUpstream pushes with --set-upstream, so future pushes/pulls of
+	// branch default to this remote.
+	SetUpstream bool
+	// NoVerify skips the pre-push hook.
+	NoVerify bool
+	// All pushes every local branch with --all. Mutually exclusive with
+	// Mirror and with an explicit branch.
+	All bool
+	// Mirror pushes every ref (branches, tags, notes) with --mirror,
+	// deleting remote refs that no longer exist locally. Mutually
+	// exclusive with All and with an explicit branch.
+	Mirror bool
+}
+
+// Push pushes remote/branch. Empty values fall back to git's own defaults.
+func (s *Service) Push(remote, branch string, opts PushOptions) (string, error) {
+	args := []string{"push"}
+	if opts.Force {
+		args = append(args, "--force")
+	}
+	if opts.SetUpstream {
+		args = append(args, "--set-upstream")
+	}
+	if opts.NoVerify {
+		args = append(args, "--no-verify")
+	}
+	if opts.Mirror {
+		args = append(args, "--mirror")
+	} else if opts.All {
+		args = append(args, "--all")
+	}
+	if remote != "" {
+		args = append(args, remote)
+	}
+	if branch != "" && !opts.All && !opts.Mirror {
+		args = append(args, branch)
+	}
+	return s.runNetwork(args...)
+}
+
+// PullOptions configures a Pull invocation. Rebase and FFOnly are mutually
+// exclusive; leaving both unset runs a plain `git pull` (a merge, per git's
+// own default).
+type PullOptions struct {
+	// Rebase pulls with --rebase, replaying local commits on top instead
+	// of creating a merge commit.
+	Rebase bool
+	// FFOnly pulls with --ff-only, failing instead of merging or
+	// rebasing when the branches have diverged.
+	FFOnly bool
+}
+
+// Pull runs `git pull`, optionally as a rebase or fast-forward-only pull.
+func (s *Service) Pull(opts PullOptions) (string, error) {
+	args := []string{"pull"}
+	if opts.Rebase {
+		args = append(args, "--rebase")
+	} else if opts.FFOnly {
+		args = append(args, "--ff-only")
+	}
+	return s.runNetwork(args...)
+}
+
+// Fetch runs `git fetch`.
+func (s *Service) Fetch() (string, error) {
+	return s.runNetwork("fetch")
+}
+
+// Branches lists local branch names.
+func (s *Service) Branches() ([]string, error) {
+	out, err := s.run("branch", "--format=%(refname:short)")
+	if err != nil {
+		return nil, err
+	}
+	return splitNonEmptyLines(out), nil
+}
+
+// MergedBranches lists local branches already merged into ref. An empty ref
+// means "the current branch", matching `git branch --merged`'s own default.
+func (s *Service) MergedBranches(ref string) ([]string, error) {
+	args := []string{"branch", "--format=%(refname:short)", "--merged"}
+	if ref != "" {
+		args = append(args, ref)
+	}
+	out, err := s.run(args...)
+	if err != nil {
+		return nil, err
+	}
+	return splitNonEmptyLines(out), nil
+}
+
+// UnmergedBranches lists local branches not yet merged into ref (see
+// MergedBranches).
+func (s *Service) UnmergedBranches(ref string) ([]string, error) {
+	args := []string{"branch", "--format=%(refname:short)", "--no-merged"}
+	if ref != "" {
+		args = append(args, ref)
+	}
+	out, err := s.run(args...)
+	if err != nil {
+		return nil, err
+	}
+	return splitNonEmptyLines(out), nil
+}
+
+// DeleteBranch deletes name with `git branch -d` (or `-D` if force). Without
+// force, git itself refuses (and errors) if name isn't fully merged.
+func (s *Service) DeleteBranch(name string, force bool) (string, error) {
+	flag := "-d"
+	if force {
+		flag = "-D"
+	}
+	return s.run("branch", flag, name)
+}
+
+// ApplyPatch applies the patch file at path with `git apply`. With check
+// set, it validates the patch applies cleanly (`--check`) without touching
+// the working tree.
+func (s *Service) ApplyPatch(path string, check bool) (string, error) {
+	args := []string{"apply"}
+	if check {
+		args = append(args, "--check")
+	}
+	args = append(args, path)
+	return s.run(args...)
+}
+
+// ApplyMailbox applies the mbox-formatted patch file at path with `git am`,
+// creating a commit per patch (as opposed to ApplyPatch, which only touches
+// the working tree/index).
+func (s *Service) ApplyMailbox(path string) (string, error) {
+	return s.run("am", path)
+}
+
+// FormatPatch runs `git format-patch <revRange>`, writing one patch file per
+// commit in revRange into outputDir (git's own default directory if empty),
+// and returns the generated file paths.
+func (s *Service) FormatPatch(revRange, outputDir string) ([]string, error) {
+	args := []string{"format-patch"}
+	if outputDir != "" {
+		args = append(args, "-o", outputDir)
+	}
+	if revRange != "" {
+		args = append(args, revRange)
+	}
+	out, err := s.run(args...)
+	if err != nil {
+		return nil, err
+	}
+	return splitNonEmptyLines(out), nil
+}
+
+// BisectStart starts a bisection, optionally seeding the known-bad and
+// known-good revisions up front (either may be empty, to mark them
+// separately via BisectBad/BisectGood).
+func (s *Service) BisectStart(bad, good string) (string, error) {
+	args := []string{"bisect", "start"}
+	if bad != "" {
+		args = append(args, bad)
+	}
+	if good != "" {
+		args = append(args, good)
+	}
+	return s.run(args...)
+}
+
+// BisectGood marks rev (HEAD if empty) as good.
+func (s *Service) BisectGood(rev string) (string, error) {
+	args := []string{"bisect", "good"}
+	if rev != "" {
+		args = append(args, rev)
+	}
+	return s.run(args...)
+}
+
+// BisectBad marks rev (HEAD if empty) as bad.
+func (s *Service) BisectBad(rev string) (string, error) {
+	args := []string{"bisect", "bad"}
+	if rev != "" {
+		args = append(args, rev)
+	}
+	return s.run(args...)
+}
+
+// BisectReset ends the bisection and returns to the original branch/commit.
+func (s *Service) BisectReset() (string, error) {
+	return s.run("bisect", "reset")
+}
+
+// BisectRun automates the bisection by running cmd (and its args) at each
+// step, treating a zero exit as good and nonzero as bad, until git
+// identifies the first bad commit.
+func (s *Service) BisectRun(cmd string, cmdArgs ...string) (string, error) {
+	args := append([]string{"bisect", "run", cmd}, cmdArgs...)
+	return s.run(args...)
+}
+
+// ParseBisectResult scans BisectRun's output for git's "<hash> is the first
+// bad commit" line, returning the hash and true if found.
+func ParseBisectResult(output string) (hash string, ok bool) {
+	for _, line := range strings.Split(output, "\n") {
+		line = strings.TrimSpace(line)
+		if idx := strings.Index(line, " is the first bad commit"); idx > 0 {
+			return line[:idx], true
+		}
+	}
+	return "", false
+}
+
+// Describe returns the nearest tag's descriptive name for the current
+// commit (e.g. "v1.2.0-3-gabc123"), appending "-dirty" if dirty is set and
+// the working tree has uncommitted changes.
+func (s *Service) Describe(dirty bool) (string, error) {
+	args := []string{"describe", "--tags"}
+	if dirty {
+		args = append(args, "--dirty")
+	}
+	return s.run(args...)
+}
+
+// archiveFormats lists the archive formats Archive accepts; git itself
+// supports more, but these cover the release-tarball use case.
+var archiveFormats = map[string]bool{"zip": true, "tar": true, "tar.gz": true}
+
+// Archive writes a snapshot of ref (HEAD if empty) in format (zip if empty)
+// to the file at output, via `git archive`.
+func (s *Service) Archive(ref, format, output string) error {
+	if ref == "" {
+		ref = "HEAD"
+	}
+	if format == "" {
+		format = "zip"
+	}
+	if !archiveFormats[format] {
+		return fmt.Errorf("unsupported archive format: %s (want zip, tar, or tar.gz)", format)
+	}
+
+	_, err := s.run("archive", "--format="+format, "-o", output, ref)
+	return err
+}
+
+// splitNonEmptyLines splits out on newlines, trimming whitespace and
+// dropping empty lines, for the many git subcommands that report one item
+// per line.
+func splitNonEmptyLines(out string) []string {
+	var lines []string
+	for _, line := range strings.Split(out, "\n") {
+		line = strings.TrimSpace(line)
+		if line != "" {
+			lines = append(lines, line)
+		}
+	}
+	return lines
+}
+
+// LogFormat runs `git log --format=<format>`, optionally capped to the last
+// n commits (n <= 0 means no limit), for callers that need to parse commit
+// metadata in a specific shape.
+func (s *Service) LogFormat(format string, n int) (string, error) {
+	args := []string{"log", "--format=" + format}
+	if n > 0 {
+		args = append(args, fmt.Sprintf("-%d", n))
+	}
+	return s.run(args...)
+}
+
+// LsFiles lists every file tracked in the index.
+func (s *Service) LsFiles() (string, error) {
+	return s.run("ls-files")
+}
+
+// NumstatLog runs `git log --numstat`, optionally restricted to commits
+// since the given date/revision expression (empty means no restriction).
+func (s *Service) NumstatLog(since string) (string, error) {
+	args := []string{"log", "--numstat"}
+	if since != "" {
+		args = append(args, "--since="+since)
+	}
+	return s.run(args...)
+}
+
+// CheckMailmap resolves id (an "email" or "Name <email>" string) through
+// .mailmap, returning the canonical "Name <email>" it maps to.
+func (s *Service) CheckMailmap(id string) (string, error) {
+	return s.run("check-mailmap", id)
+}
+
+// RemoteURL returns the configured URL for the named remote.
+func (s *Service) RemoteURL(name string) (string, error) {
+	return s.run("remote", "get-url", name)
+}
+
+// AddRemote adds a remote named name pointing at url.
+func (s *Service) AddRemote(name, url string) (string, error) {
+	return s.run("remote", "add", name, url)
+}
+
+// StashList lists stash entries, most recent first, one summary line per
+// entry (the same text `git stash list` prints, e.g. "stash@{0}: WIP on
+// main: 1234567 message").
+func (s *Service) StashList() ([]string, error) {
+	out, err := s.run("stash", "list")
+	if err != nil {
+		return nil, err
+	}
+	return splitNonEmptyLines(out), nil
+}
+
+// AheadBehind reports how many commits the current branch is ahead of and
+// behind its upstream. It returns ok=false (with no error) when the
+// current branch has no upstream configured, since that's an expected,
+// common state rather than a failure.
+func (s *Service) AheadBehind() (ahead, behind int, ok bool, err error) {
+	upstream, err := s.run("rev-parse", "--abbrev-ref", "--symbolic-full-name", "@{u}")
+	if err != nil {
+		return 0, 0, false, nil
+	}
+	out, err := s.run("rev-list", "--left-right", "--count", "HEAD..."+strings.TrimSpace(upstream))
+	if err != nil {
+		return 0, 0, false, err
+	}
+	fields := strings.Fields(out)
+	if len(fields) != 2 {
+		return 0, 0, false, fmt.Errorf("parsing rev-list --left-right --count output %q", out)
+	}
+	ahead, err = strconv.Atoi(fields[0])
+	if err != nil {
+		return 0, 0, false, fmt.Errorf("parsing ahead count %q: %w", fields[0], err)
+	}
+	behind, err = strconv.Atoi(fields[1])
+	if err != nil {
+		return 0, 0, false, fmt.Errorf("parsing behind count %q: %w", fields[1], err)
+	}
+	return ahead, behind, true, nil
+}
+
+// HasCommits reports whether HEAD resolves to a commit. It's false for a
+// freshly `git init`'d repository, whose HEAD points at an unborn branch.
+func (s *Service) HasCommits() bool {
+	_, err := s.run("rev-parse", "--verify", "HEAD")
+	return err == nil
+}
+
+// CurrentBranch returns the name of the checked-out branch. It uses
+// symbolic-ref rather than `rev-parse --abbrev-ref HEAD` so it also
+// succeeds on an unborn HEAD (a freshly `git init`'d repo with no commits
+// yet), where rev-parse fails with "ambiguous argument 'HEAD'".
+func (s *Service) CurrentBranch() (string, error) {
+	return s.run("symbolic-ref", "--short", "HEAD")
+}
+
+// History returns `git log` output limited to n entries (0 means no limit).
+func (s *Service) History(n int) (string, error) {
+	args := []string{"log", "--oneline"}
+	if n > 0 {
+		args = append(args, fmt.Sprintf("-%d", n))
+	}
+	return s.run(args...)
+}
+
+// Commit is a single log entry's hash and subject.
+type Commit struct {
+	Hash    string
+	Message string
+}
+
+// fileLogFormat separates hash and subject with a unit separator so commit
+// messages containing spaces parse unambiguously.
+const fileLogFormat = "%h\x1f%s"
+
+// FileLog returns up to n commits (0 means no limit) that touched path,
+// following renames.
+func (s *Service) FileLog(path string, n int) ([]Commit, error) {
+	args := []string{"log", "--follow", "--format=" + fileLogFormat}
+	if n > 0 {
+		args = append(args, fmt.Sprintf("-%d", n))
+	}
+	args = append(args, "--", path)
+
+	out, err := s.run(args...)
+	if err != nil {
+		if strings.Contains(err.Error(), "unknown revision") || strings.Contains(err.Error(), "does not exist") {
+			return nil, fmt.Errorf("path not found in repo: %s", path)
+		}
+		return nil, err
+	}
+
+	if out == "" {
+		return nil, fmt.Errorf("no history found for path: %s", path)
+	}
+
+	var commits []Commit
+	for _, line := range strings.Split(out, "\n") {
+		parts := strings.SplitN(line, "\x1f", 2)
+		if len(parts) != 2 {
+			continue
+		}
+		commits = append(commits, Commit{Hash: parts[0], Message: parts[1]})
+	}
+	return commits, nil
+}
+
+// DiffOptions configures a Diff invocation.
+type DiffOptions struct {
+	// Ref1 and Ref2 identify the two points to compare. Ref2 empty means
+	// "compare Ref1 against the working tree" (or the index, with Staged).
+	// Both empty means the default working-tree-vs-index/HEAD diff.
+	Ref1 string
+	Ref2 string
+
+	// Staged shows the diff between the index and HEAD.
+	Staged bool
+
+	// Stat renders a diffstat summary instead of the full patch.
+	Stat bool
+
+	// NameOnly lists only the paths that changed.
+	NameOnly bool
+}
+
+// buildDiffArgs turns opts into the `git diff` argument list. It is a pure
+// function so tests can assert on argument construction without shelling
+// out to git.
+func buildDiffArgs(opts DiffOptions) []string {
+	args := []string{"diff"}
+
+	if opts.Stat {
+		args = append(args, "--stat")
+	}
+	if opts.NameOnly {
+		args = append(args, "--name-only")
+	}
+	if opts.Staged {
+		args = append(args, "--staged")
+	}
+
+	switch {
+	case opts.Ref1 != "" && opts.Ref2 != "":
+		args = append(args, opts.Ref1, opts.Ref2)
+	case opts.Ref1 != "":
+		args = append(args, opts.Ref1)
+	}
+
+	return args
+}
+
+// Diff shows changes according to opts. With no refs set it behaves like the
+// plain working-tree diff; with Ref1/Ref2 set it compares two arbitrary refs.
+func (s *Service) Diff(opts DiffOptions) (string, error) {
+	return s.run(buildDiffArgs(opts)...)
+}
+
+// CloneOptions configures a Clone invocation's network behavior.
+type CloneOptions struct {
+	Timeout  time.Duration
+	ProxyURL string
+}
+
+// Clone clones url into destDir, which must not already exist. It's a
+// package-level function rather than a Service method since there's no
+// existing repo to root a Service at until after it runs.
+func Clone(url, destDir string, opts CloneOptions) (string, error) {
+	svc := &Service{Dir: filepath.Dir(destDir), Timeout: opts.Timeout, ProxyURL: opts.ProxyURL}
+	return svc.runNetwork("clone", url, filepath.Base(destDir))
+}
+
+// CompareRefs reports how many commits head is ahead of and behind base,
+// plus the one-line log of commits unique to head (the base..head range).
+func (s *Service) CompareRefs(base, head string) (ahead, behind int, commits []string, err error) {
+	out, err := s.run("rev-list", "--left-right", "--count", base+"..."+head)
+	if err != nil {
+		return 0, 0, nil, err
+	}
+	fields := strings.Fields(out)
+	if len(fields) != 2 {
+		return 0, 0, nil, fmt.Errorf("parsing rev-list --left-right --count output %q", out)
+	}
+	behind, err = strconv.Atoi(fields[0])
+	if err != nil {
+		return 0, 0, nil, fmt.Errorf("parsing behind count %q: %w", fields[0], err)
+	}
+	ahead, err = strconv.Atoi(fields[1])
+	if err != nil {
+		return 0, 0, nil, fmt.Errorf("parsing ahead count %q: %w", fields[1], err)
+	}
+
+	log, err := s.run("log", "--oneline", base+".."+head)
+	if err != nil {
+		return 0, 0, nil, err
+	}
+	return ahead, behind, splitNonEmptyLines(log), nil
+}
+
+// CommitDetail is one commit's subject and body, used to derive a PR's
+// default title and description from the commits it introduces.
+type CommitDetail struct {
+	Subject string
+	Body    string
+}
+
+// commitDetailFormat separates a commit's subject and body with a unit
+// separator, and commits from each other with a record separator, so
+// multi-line bodies parse unambiguously.
+const commitDetailFormat = "%s\x1f%b\x1e"
+
+// CommitsInRange returns the commits reachable from head but not base (the
+// base..head range), oldest first — the same commits a PR against base
+// would introduce.
+func (s *Service) CommitsInRange(base, head string) ([]CommitDetail, error) {
+	out, err := s.run("log", "--reverse", "--format="+commitDetailFormat, base+".."+head)
+	if err != nil {
+		return nil, err
+	}
+
+	var commits []CommitDetail
+	for _, record := range strings.Split(out, "\x1e") {
+		record = strings.TrimPrefix(record, "\n")
+		if strings.TrimSpace(record) == "" {
+			continue
+		}
+		parts := strings.SplitN(record, "\x1f", 2)
+		body := ""
+		if len(parts) == 2 {
+			body = strings.TrimSpace(parts[1])
+		}
+		commits = append(commits, CommitDetail{Subject: parts[0], Body: body})
+	}
+	return commits, nil
+}
+
+// ParseDiffRefs splits a `ref1..ref2` or `ref1 ref2` argument form into two
+// refs. A single ref (or no ref) yields ref2 == "".
+func ParseDiffRefs(args []string) (ref1, ref2 string) {
+	if len(args) == 0 {
+		return "", ""
+	}
+
+	if strings.Contains(args[0], "..") {
+		parts := strings.SplitN(args[0], "..", 2)
+		return parts[0], parts[1]
+	}
+
+	if len(args) >= 2 {
+		return args[0], args[1]
+	}
+
+	return args[0], ""
+}
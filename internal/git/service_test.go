@@ -0,0 +1,269 @@
+package git
+
+import (
+	"bytes"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"reflect"
+	"runtime"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestVerboseEchoesCommand(t *testing.T) {
+	svc := NewService(t.TempDir())
+	svc.Verbose = true
+	var buf bytes.Buffer
+	svc.Echo = &buf
+
+	// A guaranteed-failing command still exercises the echo path in run().
+	_, _ = svc.run("this-is-not-a-git-subcommand")
+
+	if !strings.Contains(buf.String(), "+ git this-is-not-a-git-subcommand") {
+		t.Fatalf("expected echoed command, got %q", buf.String())
+	}
+}
+
+func TestRunTimesOut(t *testing.T) {
+	if _, err := exec.LookPath("git"); err != nil {
+		t.Skip("git not installed")
+	}
+
+	svc := NewService(t.TempDir())
+	svc.Timeout = 1 * time.Nanosecond
+
+	_, err := svc.run("--version")
+	if err == nil {
+		t.Fatal("expected a timeout error")
+	}
+	if !strings.Contains(err.Error(), "timed out") {
+		t.Errorf("error = %q, want it to mention a timeout", err.Error())
+	}
+}
+
+func TestRunNetworkAppliesProxy(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("shim script is POSIX shell")
+	}
+
+	shimDir := t.TempDir()
+	shim := filepath.Join(shimDir, "git")
+	script := "#!/bin/sh\necho \"HTTPS_PROXY=$HTTPS_PROXY\"\n"
+	if err := os.WriteFile(shim, []byte(script), 0o755); err != nil {
+		t.Fatalf("write shim: %v", err)
+	}
+
+	oldPath := os.Getenv("PATH")
+	os.Setenv("PATH", shimDir+string(os.PathListSeparator)+oldPath)
+	defer os.Setenv("PATH", oldPath)
+
+	svc := NewService(t.TempDir())
+	svc.ProxyURL = "http://proxy.internal:8080"
+
+	out, err := svc.runNetwork("fetch")
+	if err != nil {
+		t.Fatalf("runNetwork: %v", err)
+	}
+	if out != "HTTPS_PROXY=http://proxy.internal:8080" {
+		t.Errorf("out = %q, want proxy env to be set", out)
+	}
+}
+
+func TestPushPassesRemoteAndBranchInOrder(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("shim script is POSIX shell")
+	}
+
+	shimDir := t.TempDir()
+	shim := filepath.Join(shimDir, "git")
+	script := "#!/bin/sh\necho \"$@\"\n"
+	if err := os.WriteFile(shim, []byte(script), 0o755); err != nil {
+		t.Fatalf("write shim: %v", err)
+	}
+
+	oldPath := os.Getenv("PATH")
+	os.Setenv("PATH", shimDir+string(os.PathListSeparator)+oldPath)
+	defer os.Setenv("PATH", oldPath)
+
+	svc := NewService(t.TempDir())
+
+	out, err := svc.Push("origin", "feature", PushOptions{})
+	if err != nil {
+		t.Fatalf("Push: %v", err)
+	}
+	if out != "push origin feature" {
+		t.Errorf("git invoked with args %q, want \"push origin feature\"", out)
+	}
+}
+
+func TestBuildDiffArgs(t *testing.T) {
+	tests := []struct {
+		name string
+		opts DiffOptions
+		want []string
+	}{
+		{
+			name: "working tree",
+			opts: DiffOptions{},
+			want: []string{"diff"},
+		},
+		{
+			name: "ref to ref",
+			opts: DiffOptions{Ref1: "main", Ref2: "feature"},
+			want: []string{"diff", "main", "feature"},
+		},
+		{
+			name: "single ref",
+			opts: DiffOptions{Ref1: "main"},
+			want: []string{"diff", "main"},
+		},
+		{
+			name: "stat",
+			opts: DiffOptions{Ref1: "main", Ref2: "feature", Stat: true},
+			want: []string{"diff", "--stat", "main", "feature"},
+		},
+		{
+			name: "name only",
+			opts: DiffOptions{Ref1: "main", Ref2: "feature", NameOnly: true},
+			want: []string{"diff", "--name-only", "main", "feature"},
+		},
+		{
+			name: "staged",
+			opts: DiffOptions{Staged: true},
+			want: []string{"diff", "--staged"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := buildDiffArgs(tt.opts)
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("buildDiffArgs(%+v) = %v, want %v", tt.opts, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestFileLog(t *testing.T) {
+	if _, err := exec.LookPath("git"); err != nil {
+		t.Skip("git not installed")
+	}
+
+	dir := t.TempDir()
+	svc := NewService(dir)
+	run := func(args ...string) {
+		cmd := exec.Command("git", args...)
+		cmd.Dir = dir
+		if out, err := cmd.CombinedOutput(); err != nil {
+			t.Fatalf("git %v: %v: %s", args, err, out)
+		}
+	}
+	run("init", "-q")
+	run("config", "user.email", "test@example.com")
+	run("config", "user.name", "test")
+
+	path := filepath.Join(dir, "notes.txt")
+	os.WriteFile(path, []byte("v1"), 0o644)
+	run("add", "notes.txt")
+	run("commit", "-q", "-m", "add notes")
+	os.WriteFile(path, []byte("v2"), 0o644)
+	run("add", "notes.txt")
+	run("commit", "-q", "-m", "update notes")
+
+	commits, err := svc.FileLog("notes.txt", 0)
+	if err != nil {
+		t.Fatalf("FileLog: %v", err)
+	}
+	if len(commits) != 2 {
+		t.Fatalf("got %d commits, want 2: %+v", len(commits), commits)
+	}
+	if commits[0].Message != "update notes" || commits[1].Message != "add notes" {
+		t.Errorf("unexpected commit order: %+v", commits)
+	}
+}
+
+func TestFileLogUnknownPath(t *testing.T) {
+	if _, err := exec.LookPath("git"); err != nil {
+		t.Skip("git not installed")
+	}
+
+	dir := t.TempDir()
+	svc := NewService(dir)
+	cmd := exec.Command("git", "init", "-q")
+	cmd.Dir = dir
+	if out, err := cmd.CombinedOutput(); err != nil {
+		t.Fatalf("git init: %v: %s", err, out)
+	}
+
+	if _, err := svc.FileLog("does-not-exist.txt", 0); err == nil {
+		t.Error("expected error for path with no history")
+	}
+}
+
+func TestParseDiffRefs(t *testing.T) {
+	tests := []struct {
+		name string
+		args []string
+		ref1 string
+		ref2 string
+	}{
+		{"none", nil, "", ""},
+		{"dotdot", []string{"main..feature"}, "main", "feature"},
+		{"two args", []string{"main", "feature"}, "main", "feature"},
+		{"single", []string{"main"}, "main", ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ref1, ref2 := ParseDiffRefs(tt.args)
+			if ref1 != tt.ref1 || ref2 != tt.ref2 {
+				t.Errorf("ParseDiffRefs(%v) = (%q, %q), want (%q, %q)", tt.args, ref1, ref2, tt.ref1, tt.ref2)
+			}
+		})
+	}
+}
+
+func TestInitCreatesConfiguredDefaultBranch(t *testing.T) {
+	if _, err := exec.LookPath("git"); err != nil {
+		t.Skip("git not installed")
+	}
+
+	dir := t.TempDir()
+	svc := NewService(dir)
+
+	if _, err := svc.Init("trunk"); err != nil {
+		t.Fatalf("Init: %v", err)
+	}
+
+	branch, err := svc.CurrentBranch()
+	if err != nil {
+		t.Fatalf("CurrentBranch: %v", err)
+	}
+	if branch != "trunk" {
+		t.Errorf("branch = %q, want %q", branch, "trunk")
+	}
+	if !svc.IsRepo() {
+		t.Error("IsRepo() = false after Init")
+	}
+}
+
+func TestInitDefaultsBranchToMain(t *testing.T) {
+	if _, err := exec.LookPath("git"); err != nil {
+		t.Skip("git not installed")
+	}
+
+	svc := NewService(t.TempDir())
+	if _, err := svc.Init(""); err != nil {
+		t.Fatalf("Init: %v", err)
+	}
+
+	branch, err := svc.CurrentBranch()
+	if err != nil {
+		t.Fatalf("CurrentBranch: %v", err)
+	}
+	if branch != "main" {
+		t.Errorf("branch = %q, want %q", branch, "main")
+	}
+}
@@ -0,0 +1,58 @@
+package git
+
+import (
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// Shortlog runs `git shortlog`, grouping commits by author with their
+// subject lines. since restricts to commits after that date (passed
+// straight through to --since) if non-empty; noMerges excludes merge
+// commits.
+func (s *Service) Shortlog(since string, noMerges bool) (string, error) {
+	args := []string{"shortlog"}
+	if noMerges {
+		args = append(args, "--no-merges")
+	}
+	if since != "" {
+		args = append(args, "--since="+since)
+	}
+	return s.run(args...)
+}
+
+// ShortlogEntry is one author's grouping from Shortlog's output: their
+// commit count and the subject line of each of their commits.
+type ShortlogEntry struct {
+	Author   string
+	Count    int
+	Subjects []string
+}
+
+// shortlogAuthorPattern matches a shortlog author header, e.g.
+// "Jane Doe (3):".
+var shortlogAuthorPattern = regexp.MustCompile(`^(.+) \((\d+)\):$`)
+
+// ParseShortlog parses `git shortlog`'s default (non -s) output into one
+// ShortlogEntry per author.
+func ParseShortlog(output string) []ShortlogEntry {
+	var entries []ShortlogEntry
+	var current *ShortlogEntry
+
+	for _, line := range strings.Split(output, "\n") {
+		if m := shortlogAuthorPattern.FindStringSubmatch(line); m != nil {
+			count, _ := strconv.Atoi(m[2])
+			entries = append(entries, ShortlogEntry{Author: m[1], Count: count})
+			current = &entries[len(entries)-1]
+			continue
+		}
+
+		subject := strings.TrimSpace(line)
+		if subject == "" || current == nil {
+			continue
+		}
+		current.Subjects = append(current.Subjects, subject)
+	}
+
+	return entries
+}
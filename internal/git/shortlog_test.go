@@ -0,0 +1,33 @@
+package git
+
+import "testing"
+
+func TestParseShortlog(t *testing.T) {
+	output := "Alice (2):\n      Fix bug\n      Add feature\n\nBob (1):\n      Something else\n"
+
+	entries := ParseShortlog(output)
+
+	if len(entries) != 2 {
+		t.Fatalf("expected 2 entries, got %d", len(entries))
+	}
+
+	if entries[0].Author != "Alice" || entries[0].Count != 2 {
+		t.Errorf("entry 0 = %+v, want Alice (2)", entries[0])
+	}
+	if len(entries[0].Subjects) != 2 || entries[0].Subjects[0] != "Fix bug" || entries[0].Subjects[1] != "Add feature" {
+		t.Errorf("entry 0 subjects = %v, want [Fix bug, Add feature]", entries[0].Subjects)
+	}
+
+	if entries[1].Author != "Bob" || entries[1].Count != 1 {
+		t.Errorf("entry 1 = %+v, want Bob (1)", entries[1])
+	}
+	if len(entries[1].Subjects) != 1 || entries[1].Subjects[0] != "Something else" {
+		t.Errorf("entry 1 subjects = %v, want [Something else]", entries[1].Subjects)
+	}
+}
+
+func TestParseShortlogEmpty(t *testing.T) {
+	if entries := ParseShortlog(""); len(entries) != 0 {
+		t.Errorf("expected no entries for empty output, got %v", entries)
+	}
+}
@@ -0,0 +1,101 @@
+package git
+
+import "strings"
+
+// StatusEntry is one line of `git status --porcelain` output, split into
+// its index (staged) and worktree status codes and the file path. Index and
+// Worktree are ' ' when that side has no change.
+type StatusEntry struct {
+	Index    byte
+	Worktree byte
+	Path     string
+}
+
+// RepoStatus is repository status parsed from `git status --porcelain`
+// output into per-file entries, so callers can categorize and render it
+// without re-parsing raw text themselves.
+type RepoStatus struct {
+	Entries []StatusEntry
+}
+
+// ParseStatus parses `git status --porcelain` (v1) output into a RepoStatus.
+func ParseStatus(porcelain string) RepoStatus {
+	var status RepoStatus
+	for _, line := range strings.Split(porcelain, "\n") {
+		if len(line) < 3 {
+			continue
+		}
+		path := line[3:]
+		if idx := strings.Index(path, " -> "); idx != -1 {
+			path = path[idx+4:]
+		}
+		status.Entries = append(status.Entries, StatusEntry{
+			Index:    line[0],
+			Worktree: line[1],
+			Path:     path,
+		})
+	}
+	return status
+}
+
+// conflictCodes are the XY combinations git status uses for an unmerged
+// path, per git-status(1)'s "Unmerged" table.
+var conflictCodes = map[[2]byte]bool{
+	{'D', 'D'}: true,
+	{'A', 'U'}: true,
+	{'U', 'D'}: true,
+	{'U', 'A'}: true,
+	{'D', 'U'}: true,
+	{'A', 'A'}: true,
+	{'U', 'U'}: true,
+}
+
+func isConflicted(e StatusEntry) bool {
+	return conflictCodes[[2]byte{e.Index, e.Worktree}]
+}
+
+// Staged returns entries with a change staged in the index, excluding
+// unmerged paths (those show up under Conflicted instead).
+func (s RepoStatus) Staged() []StatusEntry {
+	var out []StatusEntry
+	for _, e := range s.Entries {
+		if e.Index != ' ' && e.Index != '?' && !isConflicted(e) {
+			out = append(out, e)
+		}
+	}
+	return out
+}
+
+// Modified returns entries with unstaged worktree changes to a tracked
+// file, excluding unmerged paths.
+func (s RepoStatus) Modified() []StatusEntry {
+	var out []StatusEntry
+	for _, e := range s.Entries {
+		if e.Worktree != ' ' && e.Worktree != '?' && !isConflicted(e) {
+			out = append(out, e)
+		}
+	}
+	return out
+}
+
+// Untracked returns entries for files git isn't tracking yet.
+func (s RepoStatus) Untracked() []StatusEntry {
+	var out []StatusEntry
+	for _, e := range s.Entries {
+		if e.Index == '?' && e.Worktree == '?' {
+			out = append(out, e)
+		}
+	}
+	return out
+}
+
+// Conflicted returns entries for unmerged paths.
+func (s RepoStatus) Conflicted() []StatusEntry {
+	var out []StatusEntry
+	for _, e := range s.Entries {
+		if isConflicted(e) {
+			out = append(out, e)
+		}
+	}
+	return out
+}
@@ -0,0 +1,30 @@
+package git
+
+import "testing"
+
+func TestParseStatusCategorizesEntries(t *testing.T) {
+	porcelain := "M  staged.txt\n M modified.txt\n?? untracked.txt\nUU conflicted.txt\n"
+	status := ParseStatus(porcelain)
+
+	assertPaths(t, "Staged", status.Staged(), "staged.txt")
+	assertPaths(t, "Modified", status.Modified(), "modified.txt")
+	assertPaths(t, "Untracked", status.Untracked(), "untracked.txt")
+	assertPaths(t, "Conflicted", status.Conflicted(), "conflicted.txt")
+}
+
+func TestParseStatusHandlesRenames(t *testing.T) {
+	status := ParseStatus("R  old.txt -> new.txt\n")
+	assertPaths(t, "Staged", status.Staged(), "new.txt")
+}
+
+func assertPaths(t *testing.T, label string, entries []StatusEntry, want ...string) {
+	t.Helper()
+	if len(entries) != len(want) {
+		t.Fatalf("%s: got %d entries, want %d: %+v", label, len(entries), len(want), entries)
+	}
+	for i, e := range entries {
+		if e.Path != want[i] {
+			t.Fatalf("%s[%d].Path = %q, want %q", label, i, e.Path, want[i])
+		}
+	}
+}
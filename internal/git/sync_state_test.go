@@ -0,0 +1,101 @@
+package git
+
+import (
+	"os"
+	"os/exec"
+	"path/filepath"
+	"testing"
+)
+
+// setupConflictingRepo builds a repo with two branches ("main" and "feature")
+// that both modified the same line of the same file, so merging or rebasing
+// one onto the other conflicts.
+func setupConflictingRepo(t *testing.T) string {
+	t.Helper()
+	if _, err := exec.LookPath("git"); err != nil {
+		t.Skip("git not installed")
+	}
+
+	dir := t.TempDir()
+	run := func(args ...string) {
+		cmd := exec.Command("git", args...)
+		cmd.Dir = dir
+		if out, err := cmd.CombinedOutput(); err != nil {
+			t.Fatalf("git %v: %v: %s", args, err, out)
+		}
+	}
+	write := func(content string) {
+		if err := os.WriteFile(filepath.Join(dir, "f.txt"), []byte(content), 0o644); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	run("init", "-q", "-b", "main")
+	run("config", "user.email", "test@example.com")
+	run("config", "user.name", "test")
+	write("base\n")
+	run("add", ".")
+	run("commit", "-q", "-m", "base")
+
+	run("checkout", "-q", "-b", "feature")
+	write("feature change\n")
+	run("add", ".")
+	run("commit", "-q", "-m", "feature change")
+
+	run("checkout", "-q", "main")
+	write("main change\n")
+	run("add", ".")
+	run("commit", "-q", "-m", "main change")
+
+	return dir
+}
+
+func TestMergeInProgressDetectsConflict(t *testing.T) {
+	dir := setupConflictingRepo(t)
+	svc := NewService(dir)
+
+	if svc.MergeInProgress() {
+		t.Fatal("MergeInProgress() = true before any merge was attempted")
+	}
+
+	// This is expected to fail with a conflict; ignore the error.
+	_, _ = svc.run("merge", "feature")
+
+	if !svc.MergeInProgress() {
+		t.Fatal("MergeInProgress() = false after a conflicting merge")
+	}
+
+	if _, err := svc.MergeAbort(); err != nil {
+		t.Fatalf("MergeAbort: %v", err)
+	}
+	if svc.MergeInProgress() {
+		t.Fatal("MergeInProgress() = true after MergeAbort")
+	}
+}
+
+func TestRebaseInProgressDetectsConflict(t *testing.T) {
+	dir := setupConflictingRepo(t)
+	svc := NewService(dir)
+
+	if svc.RebaseInProgress() {
+		t.Fatal("RebaseInProgress() = true before any rebase was attempted")
+	}
+
+	if _, err := svc.run("checkout", "feature"); err != nil {
+		t.Fatalf("checkout feature: %v", err)
+	}
+
+	// This is expected to fail with a conflict; ignore the error.
+	_, _ = svc.run("rebase", "main")
+
+	if !svc.RebaseInProgress() {
+		t.Fatal("RebaseInProgress() = false after a conflicting rebase")
+	}
+
+	if _, err := svc.RebaseAbort(); err != nil {
+		t.Fatalf("RebaseAbort: %v", err)
+	}
+	if svc.RebaseInProgress() {
+		t.Fatal("RebaseInProgress() = true after RebaseAbort")
+	}
+}
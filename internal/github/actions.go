@@ -0,0 +1,86 @@
+package github
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/url"
+)
+
+// WorkflowRun is the subset of GitHub's workflow run object arngit needs to
+// show recent CI activity.
+type WorkflowRun struct {
+	ID         int64  `json:"id"`
+	Name       string `json:"name"`
+	HeadBranch string `json:"head_branch"`
+	Status     string `json:"status"`
+	Conclusion string `json:"conclusion"`
+	HTMLURL    string `json:"html_url"`
+	Actor      struct {
+		Login string `json:"login"`
+	} `json:"actor"`
+}
+
+// workflowRunsPage is the shape of one page of GitHub's list-workflow-runs
+// response: unlike most list endpoints it wraps the array in an object
+// rather than returning it bare.
+type workflowRunsPage struct {
+	WorkflowRuns []WorkflowRun `json:"workflow_runs"`
+}
+
+// WorkflowRunOptions narrows ListWorkflowRuns to a branch and/or a
+// conclusion/status, mirroring the query parameters GitHub's endpoint
+// accepts. Empty fields are omitted from the request.
+type WorkflowRunOptions struct {
+	Branch string
+	Status string
+}
+
+func workflowRunsPath(owner, repo string, opts WorkflowRunOptions) string {
+	path := fmt.Sprintf("/repos/%s/%s/actions/runs", owner, repo)
+	query := url.Values{}
+	if opts.Branch != "" {
+		query.Set("branch", opts.Branch)
+	}
+	if opts.Status != "" {
+		query.Set("status", opts.Status)
+	}
+	if encoded := query.Encode(); encoded != "" {
+		path += "?" + encoded
+	}
+	return path
+}
+
+// ListWorkflowRuns returns the workflow runs for owner/repo matching opts,
+// most recent first, as GitHub orders them.
+func (c *Client) ListWorkflowRuns(owner, repo string, opts WorkflowRunOptions) ([]WorkflowRun, error) {
+	var runs []WorkflowRun
+	err := c.getAllPages(workflowRunsPath(owner, repo, opts), func(page []byte) error {
+		var batch workflowRunsPage
+		if err := json.Unmarshal(page, &batch); err != nil {
+			return err
+		}
+		runs = append(runs, batch.WorkflowRuns...)
+		return nil
+	})
+	return runs, err
+}
+
+// RerunWorkflowRun re-runs the workflow run identified by runID on
+// owner/repo.
+func (c *Client) RerunWorkflowRun(owner, repo string, runID int64) error {
+	_, err := c.post(fmt.Sprintf("/repos/%s/%s/actions/runs/%d/rerun", owner, repo, runID), nil)
+	return err
+}
+
+// dispatchWorkflowRequest is the POST body for DispatchWorkflow.
+type dispatchWorkflowRequest struct {
+	Ref string `json:"ref"`
+}
+
+// DispatchWorkflow triggers a workflow_dispatch event for the workflow
+// (its file name, e.g. "ci.yml", or its numeric ID as a string) on ref.
+func (c *Client) DispatchWorkflow(owner, repo, workflow, ref string) error {
+	path := fmt.Sprintf("/repos/%s/%s/actions/workflows/%s/dispatches", owner, repo, workflow)
+	_, err := c.post(path, dispatchWorkflowRequest{Ref: ref})
+	return err
+}
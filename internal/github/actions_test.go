@@ -0,0 +1,123 @@
+package github
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestListWorkflowRunsDecodesResponse(t *testing.T) {
+	var gotQuery string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/repos/acme/widgets/actions/runs" {
+			t.Errorf("path = %s", r.URL.Path)
+		}
+		gotQuery = r.URL.RawQuery
+		w.Write([]byte(`{"total_count": 1, "workflow_runs": [
+			{"id": 42, "name": "CI", "head_branch": "main", "status": "completed", "conclusion": "failure", "html_url": "https://github.com/acme/widgets/actions/runs/42", "actor": {"login": "octocat"}}
+		]}`))
+	}))
+	defer server.Close()
+
+	client, err := NewClient("", t.TempDir(), 0, "")
+	if err != nil {
+		t.Fatalf("NewClient: %v", err)
+	}
+	client.BaseURL = server.URL
+
+	runs, err := client.ListWorkflowRuns("acme", "widgets", WorkflowRunOptions{Branch: "main", Status: "failure"})
+	if err != nil {
+		t.Fatalf("ListWorkflowRuns: %v", err)
+	}
+	if len(runs) != 1 {
+		t.Fatalf("runs = %+v", runs)
+	}
+	run := runs[0]
+	if run.ID != 42 || run.Conclusion != "failure" || run.Actor.Login != "octocat" {
+		t.Errorf("run = %+v", run)
+	}
+	if gotQuery != "branch=main&status=failure" {
+		t.Errorf("query = %q", gotQuery)
+	}
+}
+
+func TestRerunWorkflowRunSendsPost(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost {
+			t.Errorf("method = %s, want POST", r.Method)
+		}
+		if r.URL.Path != "/repos/acme/widgets/actions/runs/42/rerun" {
+			t.Errorf("path = %s", r.URL.Path)
+		}
+		w.WriteHeader(http.StatusCreated)
+	}))
+	defer server.Close()
+
+	client, err := NewClient("", t.TempDir(), 0, "")
+	if err != nil {
+		t.Fatalf("NewClient: %v", err)
+	}
+	client.BaseURL = server.URL
+
+	if err := client.RerunWorkflowRun("acme", "widgets", 42); err != nil {
+		t.Fatalf("RerunWorkflowRun: %v", err)
+	}
+}
+
+func TestDispatchWorkflowSendsExpectedBody(t *testing.T) {
+	var gotBody dispatchWorkflowRequest
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost {
+			t.Errorf("method = %s, want POST", r.Method)
+		}
+		if r.URL.Path != "/repos/acme/widgets/actions/workflows/ci.yml/dispatches" {
+			t.Errorf("path = %s", r.URL.Path)
+		}
+		data, err := io.ReadAll(r.Body)
+		if err != nil {
+			t.Fatalf("reading body: %v", err)
+		}
+		if err := json.Unmarshal(data, &gotBody); err != nil {
+			t.Fatalf("unmarshaling body: %v", err)
+		}
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer server.Close()
+
+	client, err := NewClient("", t.TempDir(), 0, "")
+	if err != nil {
+		t.Fatalf("NewClient: %v", err)
+	}
+	client.BaseURL = server.URL
+
+	if err := client.DispatchWorkflow("acme", "widgets", "ci.yml", "main"); err != nil {
+		t.Fatalf("DispatchWorkflow: %v", err)
+	}
+	if gotBody.Ref != "main" {
+		t.Errorf("gotBody = %+v", gotBody)
+	}
+}
+
+func TestListWorkflowRunsOmitsEmptyFilters(t *testing.T) {
+	var gotQuery string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		gotQuery = r.URL.RawQuery
+		w.Write([]byte(`{"total_count": 0, "workflow_runs": []}`))
+	}))
+	defer server.Close()
+
+	client, err := NewClient("", t.TempDir(), 0, "")
+	if err != nil {
+		t.Fatalf("NewClient: %v", err)
+	}
+	client.BaseURL = server.URL
+
+	if _, err := client.ListWorkflowRuns("acme", "widgets", WorkflowRunOptions{}); err != nil {
+		t.Fatalf("ListWorkflowRuns: %v", err)
+	}
+	if gotQuery != "" {
+		t.Errorf("query = %q, want empty", gotQuery)
+	}
+}
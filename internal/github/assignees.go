@@ -0,0 +1,43 @@
+package github
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+type addAssigneesRequest struct {
+	Assignees []string `json:"assignees"`
+}
+
+type requestReviewersRequest struct {
+	Reviewers []string `json:"reviewers"`
+}
+
+// AddAssignees assigns users to the issue or pull request numbered number
+// on owner/repo, returning its full assignee list.
+func (c *Client) AddAssignees(owner, repo string, number int, users []string) ([]string, error) {
+	body, err := c.post(fmt.Sprintf("/repos/%s/%s/issues/%d/assignees", owner, repo, number), addAssigneesRequest{Assignees: users})
+	if err != nil {
+		return nil, err
+	}
+	var issue struct {
+		Assignees []struct {
+			Login string `json:"login"`
+		} `json:"assignees"`
+	}
+	if err := json.Unmarshal(body, &issue); err != nil {
+		return nil, err
+	}
+	assignees := make([]string, len(issue.Assignees))
+	for i, a := range issue.Assignees {
+		assignees[i] = a.Login
+	}
+	return assignees, nil
+}
+
+// RequestReviewers requests review from users on the pull request numbered
+// prNumber on owner/repo.
+func (c *Client) RequestReviewers(owner, repo string, prNumber int, users []string) error {
+	_, err := c.post(fmt.Sprintf("/repos/%s/%s/pulls/%d/requested_reviewers", owner, repo, prNumber), requestReviewersRequest{Reviewers: users})
+	return err
+}
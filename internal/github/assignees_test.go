@@ -0,0 +1,81 @@
+package github
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestAddAssigneesSendsExpectedBody(t *testing.T) {
+	var gotBody addAssigneesRequest
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost {
+			t.Errorf("method = %s, want POST", r.Method)
+		}
+		if r.URL.Path != "/repos/acme/widgets/issues/5/assignees" {
+			t.Errorf("path = %s", r.URL.Path)
+		}
+		data, err := io.ReadAll(r.Body)
+		if err != nil {
+			t.Fatalf("reading body: %v", err)
+		}
+		if err := json.Unmarshal(data, &gotBody); err != nil {
+			t.Fatalf("unmarshaling body: %v", err)
+		}
+		w.Write([]byte(`{"assignees": [{"login": "alice"}, {"login": "bob"}]}`))
+	}))
+	defer server.Close()
+
+	client, err := NewClient("", t.TempDir(), 0, "")
+	if err != nil {
+		t.Fatalf("NewClient: %v", err)
+	}
+	client.BaseURL = server.URL
+
+	assignees, err := client.AddAssignees("acme", "widgets", 5, []string{"alice", "bob"})
+	if err != nil {
+		t.Fatalf("AddAssignees: %v", err)
+	}
+	if len(assignees) != 2 || assignees[0] != "alice" || assignees[1] != "bob" {
+		t.Fatalf("assignees = %+v", assignees)
+	}
+	if len(gotBody.Assignees) != 2 || gotBody.Assignees[0] != "alice" {
+		t.Errorf("gotBody = %+v", gotBody)
+	}
+}
+
+func TestRequestReviewersSendsExpectedBody(t *testing.T) {
+	var gotBody requestReviewersRequest
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost {
+			t.Errorf("method = %s, want POST", r.Method)
+		}
+		if r.URL.Path != "/repos/acme/widgets/pulls/9/requested_reviewers" {
+			t.Errorf("path = %s", r.URL.Path)
+		}
+		data, err := io.ReadAll(r.Body)
+		if err != nil {
+			t.Fatalf("reading body: %v", err)
+		}
+		if err := json.Unmarshal(data, &gotBody); err != nil {
+			t.Fatalf("unmarshaling body: %v", err)
+		}
+		w.Write([]byte(`{}`))
+	}))
+	defer server.Close()
+
+	client, err := NewClient("", t.TempDir(), 0, "")
+	if err != nil {
+		t.Fatalf("NewClient: %v", err)
+	}
+	client.BaseURL = server.URL
+
+	if err := client.RequestReviewers("acme", "widgets", 9, []string{"carol"}); err != nil {
+		t.Fatalf("RequestReviewers: %v", err)
+	}
+	if len(gotBody.Reviewers) != 1 || gotBody.Reviewers[0] != "carol" {
+		t.Errorf("gotBody = %+v", gotBody)
+	}
+}
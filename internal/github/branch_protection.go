@@ -0,0 +1,82 @@
+package github
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+// RequiredStatusChecks is the status-check portion of a branch protection
+// ruleset: which contexts must pass, and whether branches must be up to
+// date with the base branch before merging.
+type RequiredStatusChecks struct {
+	Strict   bool     `json:"strict"`
+	Contexts []string `json:"contexts"`
+}
+
+// RequiredPullRequestReviews is the review portion of a branch protection
+// ruleset.
+type RequiredPullRequestReviews struct {
+	RequiredApprovingReviewCount int  `json:"required_approving_review_count"`
+	DismissStaleReviews          bool `json:"dismiss_stale_reviews"`
+}
+
+// BranchProtection is the subset of a branch protection ruleset arngit
+// reads back from GET .../protection. GitHub wraps enforce_admins in an
+// object on read ({"enforce_admins": {"enabled": true}}) but accepts a
+// bare bool on write, hence the separate BranchProtectionUpdate type.
+type BranchProtection struct {
+	RequiredStatusChecks *RequiredStatusChecks `json:"required_status_checks"`
+	EnforceAdmins        struct {
+		Enabled bool `json:"enabled"`
+	} `json:"enforce_admins"`
+	RequiredPullRequestReviews *RequiredPullRequestReviews `json:"required_pull_request_reviews"`
+}
+
+// BranchProtectionUpdate is the PUT body for UpdateBranchProtection. GitHub
+// requires all four top-level fields to be present, with null meaning "no
+// restriction here" for the pointer/interface fields.
+type BranchProtectionUpdate struct {
+	RequiredStatusChecks       *RequiredStatusChecks       `json:"required_status_checks"`
+	RequiredPullRequestReviews *RequiredPullRequestReviews `json:"required_pull_request_reviews"`
+	EnforceAdmins              bool                        `json:"enforce_admins"`
+	Restrictions               any                         `json:"restrictions"`
+}
+
+// branchProtectionPath builds the API path shared by the three branch
+// protection endpoints.
+func branchProtectionPath(owner, repo, branch string) string {
+	return fmt.Sprintf("/repos/%s/%s/branches/%s/protection", owner, repo, branch)
+}
+
+// GetBranchProtection fetches the branch protection ruleset for branch, if
+// any is configured.
+func (c *Client) GetBranchProtection(owner, repo, branch string) (*BranchProtection, error) {
+	body, err := c.get(branchProtectionPath(owner, repo, branch))
+	if err != nil {
+		return nil, err
+	}
+	var protection BranchProtection
+	if err := json.Unmarshal(body, &protection); err != nil {
+		return nil, err
+	}
+	return &protection, nil
+}
+
+// UpdateBranchProtection replaces branch's protection ruleset with update,
+// returning the ruleset GitHub actually stored.
+func (c *Client) UpdateBranchProtection(owner, repo, branch string, update BranchProtectionUpdate) (*BranchProtection, error) {
+	body, err := c.put(branchProtectionPath(owner, repo, branch), update)
+	if err != nil {
+		return nil, err
+	}
+	var protection BranchProtection
+	if err := json.Unmarshal(body, &protection); err != nil {
+		return nil, err
+	}
+	return &protection, nil
+}
+
+// RemoveBranchProtection removes all protection from branch.
+func (c *Client) RemoveBranchProtection(owner, repo, branch string) error {
+	return c.delete(branchProtectionPath(owner, repo, branch))
+}
@@ -0,0 +1,109 @@
+package github
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestGetBranchProtectionDecodesResponse(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet {
+			t.Errorf("method = %s, want GET", r.Method)
+		}
+		if r.URL.Path != "/repos/acme/widgets/branches/main/protection" {
+			t.Errorf("path = %s", r.URL.Path)
+		}
+		w.Write([]byte(`{
+			"required_status_checks": {"strict": true, "contexts": ["ci"]},
+			"enforce_admins": {"enabled": true},
+			"required_pull_request_reviews": {"required_approving_review_count": 2, "dismiss_stale_reviews": true}
+		}`))
+	}))
+	defer server.Close()
+
+	client, err := NewClient("", t.TempDir(), 0, "")
+	if err != nil {
+		t.Fatalf("NewClient: %v", err)
+	}
+	client.BaseURL = server.URL
+
+	protection, err := client.GetBranchProtection("acme", "widgets", "main")
+	if err != nil {
+		t.Fatalf("GetBranchProtection: %v", err)
+	}
+	if !protection.EnforceAdmins.Enabled {
+		t.Error("expected EnforceAdmins.Enabled = true")
+	}
+	if protection.RequiredStatusChecks == nil || !protection.RequiredStatusChecks.Strict || len(protection.RequiredStatusChecks.Contexts) != 1 {
+		t.Errorf("RequiredStatusChecks = %+v", protection.RequiredStatusChecks)
+	}
+	if protection.RequiredPullRequestReviews == nil || protection.RequiredPullRequestReviews.RequiredApprovingReviewCount != 2 {
+		t.Errorf("RequiredPullRequestReviews = %+v", protection.RequiredPullRequestReviews)
+	}
+}
+
+func TestUpdateBranchProtectionSendsExpectedBody(t *testing.T) {
+	var gotBody BranchProtectionUpdate
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPut {
+			t.Errorf("method = %s, want PUT", r.Method)
+		}
+		data, err := io.ReadAll(r.Body)
+		if err != nil {
+			t.Fatalf("reading body: %v", err)
+		}
+		if err := json.Unmarshal(data, &gotBody); err != nil {
+			t.Fatalf("unmarshaling body: %v", err)
+		}
+		w.Write([]byte(`{"enforce_admins": {"enabled": true}}`))
+	}))
+	defer server.Close()
+
+	client, err := NewClient("", t.TempDir(), 0, "")
+	if err != nil {
+		t.Fatalf("NewClient: %v", err)
+	}
+	client.BaseURL = server.URL
+
+	update := BranchProtectionUpdate{
+		RequiredStatusChecks:       &RequiredStatusChecks{Strict: true, Contexts: []string{"ci"}},
+		RequiredPullRequestReviews: &RequiredPullRequestReviews{RequiredApprovingReviewCount: 1},
+		EnforceAdmins:              true,
+	}
+	if _, err := client.UpdateBranchProtection("acme", "widgets", "main", update); err != nil {
+		t.Fatalf("UpdateBranchProtection: %v", err)
+	}
+
+	if !gotBody.EnforceAdmins {
+		t.Error("expected enforce_admins = true in the PUT body")
+	}
+	if gotBody.RequiredStatusChecks == nil || !gotBody.RequiredStatusChecks.Strict {
+		t.Errorf("RequiredStatusChecks in PUT body = %+v", gotBody.RequiredStatusChecks)
+	}
+	if gotBody.Restrictions != nil {
+		t.Errorf("Restrictions in PUT body = %v, want nil", gotBody.Restrictions)
+	}
+}
+
+func TestRemoveBranchProtectionSendsDelete(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodDelete {
+			t.Errorf("method = %s, want DELETE", r.Method)
+		}
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer server.Close()
+
+	client, err := NewClient("", t.TempDir(), 0, "")
+	if err != nil {
+		t.Fatalf("NewClient: %v", err)
+	}
+	client.BaseURL = server.URL
+
+	if err := client.RemoveBranchProtection("acme", "widgets", "main"); err != nil {
+		t.Fatalf("RemoveBranchProtection: %v", err)
+	}
+}
@@ -0,0 +1,58 @@
+package github
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"os"
+	"path/filepath"
+)
+
+// cacheEntry is what's persisted per cached request: the ETag GitHub sent
+// back and the response body it validated.
+type cacheEntry struct {
+	ETag string `json:"etag"`
+	Body []byte `json:"body"`
+}
+
+// ResponseCache stores one JSON file per cached URL under dir, keyed by the
+// URL's SHA-256 so arbitrary query strings are safe file names.
+type ResponseCache struct {
+	dir string
+}
+
+// NewResponseCache roots a ResponseCache at dir, creating it if needed.
+func NewResponseCache(dir string) (*ResponseCache, error) {
+	if err := os.MkdirAll(dir, 0o700); err != nil {
+		return nil, err
+	}
+	return &ResponseCache{dir: dir}, nil
+}
+
+func (c *ResponseCache) path(key string) string {
+	sum := sha256.Sum256([]byte(key))
+	return filepath.Join(c.dir, hex.EncodeToString(sum[:])+".json")
+}
+
+// Get returns the cached ETag and body for key, if present.
+func (c *ResponseCache) Get(key string) (etag string, body []byte, ok bool) {
+	data, err := os.ReadFile(c.path(key))
+	if err != nil {
+		return "", nil, false
+	}
+
+	var entry cacheEntry
+	if err := json.Unmarshal(data, &entry); err != nil {
+		return "", nil, false
+	}
+	return entry.ETag, entry.Body, true
+}
+
+// Set stores etag/body for key, overwriting any previous entry.
+func (c *ResponseCache) Set(key, etag string, body []byte) error {
+	data, err := json.Marshal(cacheEntry{ETag: etag, Body: body})
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(c.path(key), data, 0o600)
+}
@@ -0,0 +1,44 @@
+package github
+
+import "testing"
+
+func TestResponseCacheRoundTrip(t *testing.T) {
+	cache, err := NewResponseCache(t.TempDir())
+	if err != nil {
+		t.Fatalf("NewResponseCache: %v", err)
+	}
+
+	if _, _, ok := cache.Get("https://api.github.com/repos/x/y"); ok {
+		t.Fatalf("expected no cache entry before Set")
+	}
+
+	if err := cache.Set("https://api.github.com/repos/x/y", `"abc123"`, []byte(`{"ok":true}`)); err != nil {
+		t.Fatalf("Set: %v", err)
+	}
+
+	etag, body, ok := cache.Get("https://api.github.com/repos/x/y")
+	if !ok {
+		t.Fatalf("expected cache entry after Set")
+	}
+	if etag != `"abc123"` {
+		t.Errorf("etag = %q, want %q", etag, `"abc123"`)
+	}
+	if string(body) != `{"ok":true}` {
+		t.Errorf("body = %q", body)
+	}
+}
+
+func TestResponseCacheDistinctKeys(t *testing.T) {
+	cache, err := NewResponseCache(t.TempDir())
+	if err != nil {
+		t.Fatalf("NewResponseCache: %v", err)
+	}
+
+	cache.Set("https://api.github.com/a", `"a"`, []byte("a"))
+	cache.Set("https://api.github.com/b", `"b"`, []byte("b"))
+
+	_, body, _ := cache.Get("https://api.github.com/a")
+	if string(body) != "a" {
+		t.Errorf("expected key a to keep its own body, got %q", body)
+	}
+}
@@ -0,0 +1,261 @@
+// Package github is a minimal client for the parts of the GitHub REST API
+// arngit's github_commands.go needs.
+package github
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"path/filepath"
+	"strings"
+	"time"
+)
+
+const defaultBaseURL = "https://api.github.com"
+
+// defaultTimeout bounds a request when no explicit timeout is configured.
+const defaultTimeout = 5 * time.Minute
+
+// Client talks to the GitHub REST API, caching responses by ETag so repeat
+// requests for unchanged resources don't count against rate limits.
+type Client struct {
+	Token      string
+	BaseURL    string
+	HTTPClient *http.Client
+	Cache      *ResponseCache
+}
+
+// NewClient builds a Client authenticated with token, caching responses
+// under cacheDir. token may be empty for unauthenticated requests. timeout
+// <= 0 falls back to defaultTimeout. proxyURL, if set, overrides the
+// HTTPS_PROXY/HTTP_PROXY/NO_PROXY environment for this client's requests;
+// empty defers to the environment.
+func NewClient(token, cacheDir string, timeout time.Duration, proxyURL string) (*Client, error) {
+	cache, err := NewResponseCache(filepath.Join(cacheDir, "github"))
+	if err != nil {
+		return nil, err
+	}
+	if timeout <= 0 {
+		timeout = defaultTimeout
+	}
+
+	proxyFunc, err := proxyFuncFor(proxyURL)
+	if err != nil {
+		return nil, err
+	}
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	transport.Proxy = proxyFunc
+
+	return &Client{
+		Token:      token,
+		BaseURL:    defaultBaseURL,
+		HTTPClient: &http.Client{Timeout: timeout, Transport: transport},
+		Cache:      cache,
+	}, nil
+}
+
+// proxyFuncFor returns a request-proxy resolver: a fixed proxyURL if given,
+// otherwise the standard HTTPS_PROXY/HTTP_PROXY/NO_PROXY environment
+// resolution.
+func proxyFuncFor(proxyURL string) (func(*http.Request) (*url.URL, error), error) {
+	if proxyURL == "" {
+		return http.ProxyFromEnvironment, nil
+	}
+	fixed, err := url.Parse(proxyURL)
+	if err != nil {
+		return nil, fmt.Errorf("invalid proxy URL %q: %w", proxyURL, err)
+	}
+	return http.ProxyURL(fixed), nil
+}
+
+// get issues a GET to path (e.g. "/repos/owner/name"), sending an
+// If-None-Match header when a cached ETag is available and transparently
+// returning the cached body on a 304.
+func (c *Client) get(path string) ([]byte, error) {
+	body, _, err := c.getURL(c.BaseURL + path)
+	return body, err
+}
+
+// getURL is get, but takes a full URL (rather than a path relative to
+// BaseURL) and also returns the response's Link header, so callers that
+// need to follow pagination can read the next page's URL from it.
+func (c *Client) getURL(url string) ([]byte, string, error) {
+	cachedETag, cachedBody, hasCache := c.Cache.Get(url)
+
+	req, err := http.NewRequest(http.MethodGet, url, nil)
+	if err != nil {
+		return nil, "", err
+	}
+	req.Header.Set("Accept", "application/vnd.github+json")
+	if c.Token != "" {
+		req.Header.Set("Authorization", "Bearer "+c.Token)
+	}
+	if hasCache {
+		req.Header.Set("If-None-Match", cachedETag)
+	}
+
+	resp, err := c.HTTPClient.Do(req)
+	if err != nil {
+		return nil, "", err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode == http.StatusNotModified && hasCache {
+		return cachedBody, resp.Header.Get("Link"), nil
+	}
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, "", err
+	}
+
+	if err := handleResponse(resp, body); err != nil {
+		return nil, "", err
+	}
+
+	if etag := resp.Header.Get("ETag"); etag != "" {
+		_ = c.Cache.Set(url, etag, body)
+	}
+
+	return body, resp.Header.Get("Link"), nil
+}
+
+// getWithHeaders is get, but returns the full response header set rather
+// than just the body, for callers that need something other than ETag/Link
+// (e.g. notifications' Last-Modified and X-Poll-Interval). Unlike get, it
+// never reads from or writes to the response cache, since notifications
+// unread state changes too often for ETag caching to be useful.
+func (c *Client) getWithHeaders(path string) ([]byte, http.Header, error) {
+	req, err := http.NewRequest(http.MethodGet, c.BaseURL+path, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+	req.Header.Set("Accept", "application/vnd.github+json")
+	if c.Token != "" {
+		req.Header.Set("Authorization", "Bearer "+c.Token)
+	}
+
+	resp, err := c.HTTPClient.Do(req)
+	if err != nil {
+		return nil, nil, err
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, nil, err
+	}
+	if err := handleResponse(resp, body); err != nil {
+		return nil, nil, err
+	}
+	return body, resp.Header, nil
+}
+
+// getAllPages fetches path and every subsequent page linked from its Link
+// header's rel="next" entry, calling decode once per page with that page's
+// raw JSON body. Callers unmarshal each page into their own slice type and
+// append, since Go generics-free JSON decoding can't accumulate into an
+// arbitrary out parameter without reflection.
+func (c *Client) getAllPages(path string, decode func(page []byte) error) error {
+	url := c.BaseURL + path
+	for url != "" {
+		body, link, err := c.getURL(url)
+		if err != nil {
+			return err
+		}
+		if err := decode(body); err != nil {
+			return err
+		}
+		url = nextPageURL(link)
+	}
+	return nil
+}
+
+// put issues a PUT to path with body JSON-encoded, returning the decoded
+// response body. Unlike get, PUTs are mutations and are never served from
+// or written to the response cache.
+func (c *Client) put(path string, body any) ([]byte, error) {
+	return c.send(http.MethodPut, path, body)
+}
+
+// post issues a POST to path with body JSON-encoded, returning the decoded
+// response body.
+func (c *Client) post(path string, body any) ([]byte, error) {
+	return c.send(http.MethodPost, path, body)
+}
+
+// patch issues a PATCH to path with body JSON-encoded, returning the
+// decoded response body.
+func (c *Client) patch(path string, body any) ([]byte, error) {
+	return c.send(http.MethodPatch, path, body)
+}
+
+// delete issues a DELETE to path, discarding any response body.
+func (c *Client) delete(path string) error {
+	_, err := c.send(http.MethodDelete, path, nil)
+	return err
+}
+
+// send issues a request to path with an optional JSON-encoded body,
+// returning the raw response body.
+func (c *Client) send(method, path string, body any) ([]byte, error) {
+	var reqBody io.Reader
+	if body != nil {
+		data, err := json.Marshal(body)
+		if err != nil {
+			return nil, err
+		}
+		reqBody = bytes.NewReader(data)
+	}
+
+	req, err := http.NewRequest(method, c.BaseURL+path, reqBody)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Accept", "application/vnd.github+json")
+	if body != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+	if c.Token != "" {
+		req.Header.Set("Authorization", "Bearer "+c.Token)
+	}
+
+	resp, err := c.HTTPClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	respBody, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+	if err := handleResponse(resp, respBody); err != nil {
+		return nil, err
+	}
+	return respBody, nil
+}
+
+// nextPageURL extracts the rel="next" URL from a GitHub-style Link header
+// ("<url>; rel=\"next\", <url>; rel=\"last\""), or "" if there isn't one.
+func nextPageURL(header string) string {
+	for _, part := range strings.Split(header, ",") {
+		segs := strings.Split(part, ";")
+		if len(segs) < 2 {
+			continue
+		}
+		urlPart := strings.TrimSpace(segs[0])
+		if !strings.HasPrefix(urlPart, "<") || !strings.HasSuffix(urlPart, ">") {
+			continue
+		}
+		for _, attr := range segs[1:] {
+			if strings.TrimSpace(attr) == `rel="next"` {
+				return strings.Trim(urlPart, "<>")
+			}
+		}
+	}
+	return ""
+}
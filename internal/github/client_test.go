@@ -0,0 +1,54 @@
+package github
+
+import (
+	"net/http"
+	"testing"
+	"time"
+)
+
+func TestNewClientUsesConfiguredTimeout(t *testing.T) {
+	client, err := NewClient("", t.TempDir(), 15*time.Second, "")
+	if err != nil {
+		t.Fatalf("NewClient: %v", err)
+	}
+	if client.HTTPClient.Timeout != 15*time.Second {
+		t.Errorf("Timeout = %v, want 15s", client.HTTPClient.Timeout)
+	}
+}
+
+func TestNewClientDefaultsTimeout(t *testing.T) {
+	client, err := NewClient("", t.TempDir(), 0, "")
+	if err != nil {
+		t.Fatalf("NewClient: %v", err)
+	}
+	if client.HTTPClient.Timeout != defaultTimeout {
+		t.Errorf("Timeout = %v, want default %v", client.HTTPClient.Timeout, defaultTimeout)
+	}
+}
+
+func TestNewClientUsesConfiguredProxy(t *testing.T) {
+	client, err := NewClient("", t.TempDir(), 0, "http://proxy.internal:8080")
+	if err != nil {
+		t.Fatalf("NewClient: %v", err)
+	}
+
+	transport, ok := client.HTTPClient.Transport.(*http.Transport)
+	if !ok {
+		t.Fatalf("expected *http.Transport, got %T", client.HTTPClient.Transport)
+	}
+
+	req, _ := http.NewRequest(http.MethodGet, "https://api.github.com/repos/x/y", nil)
+	proxyURL, err := transport.Proxy(req)
+	if err != nil {
+		t.Fatalf("transport.Proxy: %v", err)
+	}
+	if proxyURL == nil || proxyURL.String() != "http://proxy.internal:8080" {
+		t.Errorf("proxyURL = %v, want http://proxy.internal:8080", proxyURL)
+	}
+}
+
+func TestNewClientInvalidProxy(t *testing.T) {
+	if _, err := NewClient("", t.TempDir(), 0, "://not-a-url"); err == nil {
+		t.Error("expected error for invalid proxy URL")
+	}
+}
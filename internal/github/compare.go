@@ -0,0 +1,30 @@
+package github
+
+import "encoding/json"
+
+// CompareResult is the subset of GitHub's compare-two-commits response
+// arngit needs.
+type CompareResult struct {
+	AheadBy  int `json:"ahead_by"`
+	BehindBy int `json:"behind_by"`
+	Commits  []struct {
+		SHA    string `json:"sha"`
+		Commit struct {
+			Message string `json:"message"`
+		} `json:"commit"`
+	} `json:"commits"`
+}
+
+// CompareCommits compares base against head on owner/repo, reporting how
+// far ahead/behind head is and the commits unique to it.
+func (c *Client) CompareCommits(owner, repo, base, head string) (*CompareResult, error) {
+	body, err := c.get("/repos/" + owner + "/" + repo + "/compare/" + base + "..." + head)
+	if err != nil {
+		return nil, err
+	}
+	var result CompareResult
+	if err := json.Unmarshal(body, &result); err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
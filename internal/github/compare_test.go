@@ -0,0 +1,37 @@
+package github
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestCompareCommitsDecodesResponse(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/repos/acme/widgets/compare/main...feature" {
+			t.Errorf("path = %s", r.URL.Path)
+		}
+		w.Write([]byte(`{"ahead_by": 2, "behind_by": 1, "commits": [
+			{"sha": "aaa", "commit": {"message": "one"}},
+			{"sha": "bbb", "commit": {"message": "two"}}
+		]}`))
+	}))
+	defer server.Close()
+
+	client, err := NewClient("", t.TempDir(), 0, "")
+	if err != nil {
+		t.Fatalf("NewClient: %v", err)
+	}
+	client.BaseURL = server.URL
+
+	result, err := client.CompareCommits("acme", "widgets", "main", "feature")
+	if err != nil {
+		t.Fatalf("CompareCommits: %v", err)
+	}
+	if result.AheadBy != 2 || result.BehindBy != 1 || len(result.Commits) != 2 {
+		t.Fatalf("result = %+v", result)
+	}
+	if result.Commits[0].SHA != "aaa" || result.Commits[0].Commit.Message != "one" {
+		t.Errorf("commit = %+v", result.Commits[0])
+	}
+}
@@ -0,0 +1,62 @@
+package github
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+// DeployKey is the subset of GitHub's deploy key object arngit needs.
+type DeployKey struct {
+	ID       int64  `json:"id"`
+	Key      string `json:"key"`
+	Title    string `json:"title"`
+	ReadOnly bool   `json:"read_only"`
+}
+
+// addDeployKeyRequest is the POST body for AddDeployKey.
+type addDeployKeyRequest struct {
+	Title    string `json:"title"`
+	Key      string `json:"key"`
+	ReadOnly bool   `json:"read_only"`
+}
+
+func deployKeysPath(owner, repo string) string {
+	return fmt.Sprintf("/repos/%s/%s/keys", owner, repo)
+}
+
+// ListDeployKeys lists the deploy keys registered on owner/repo.
+func (c *Client) ListDeployKeys(owner, repo string) ([]DeployKey, error) {
+	var keys []DeployKey
+	err := c.getAllPages(deployKeysPath(owner, repo), func(page []byte) error {
+		var batch []DeployKey
+		if err := json.Unmarshal(page, &batch); err != nil {
+			return err
+		}
+		keys = append(keys, batch...)
+		return nil
+	})
+	return keys, err
+}
+
+// AddDeployKey registers key (an SSH public key) as a deploy key on
+// owner/repo. readOnly restricts it to pulling, not pushing.
+func (c *Client) AddDeployKey(owner, repo, title, key string, readOnly bool) (*DeployKey, error) {
+	body, err := c.post(deployKeysPath(owner, repo), addDeployKeyRequest{
+		Title:    title,
+		Key:      key,
+		ReadOnly: readOnly,
+	})
+	if err != nil {
+		return nil, err
+	}
+	var deployKey DeployKey
+	if err := json.Unmarshal(body, &deployKey); err != nil {
+		return nil, err
+	}
+	return &deployKey, nil
+}
+
+// DeleteDeployKey removes the deploy key identified by id from owner/repo.
+func (c *Client) DeleteDeployKey(owner, repo string, id int64) error {
+	return c.delete(fmt.Sprintf("%s/%d", deployKeysPath(owner, repo), id))
+}
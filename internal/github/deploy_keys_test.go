@@ -0,0 +1,92 @@
+package github
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestAddDeployKeySendsExpectedBody(t *testing.T) {
+	var gotBody addDeployKeyRequest
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost {
+			t.Errorf("method = %s, want POST", r.Method)
+		}
+		if r.URL.Path != "/repos/acme/widgets/keys" {
+			t.Errorf("path = %s", r.URL.Path)
+		}
+		data, err := io.ReadAll(r.Body)
+		if err != nil {
+			t.Fatalf("reading body: %v", err)
+		}
+		if err := json.Unmarshal(data, &gotBody); err != nil {
+			t.Fatalf("unmarshaling body: %v", err)
+		}
+		w.WriteHeader(http.StatusCreated)
+		w.Write([]byte(`{"id": 7, "title": "deploy", "key": "ssh-ed25519 AAAA", "read_only": true}`))
+	}))
+	defer server.Close()
+
+	client, err := NewClient("", t.TempDir(), 0, "")
+	if err != nil {
+		t.Fatalf("NewClient: %v", err)
+	}
+	client.BaseURL = server.URL
+
+	key, err := client.AddDeployKey("acme", "widgets", "deploy", "ssh-ed25519 AAAA", true)
+	if err != nil {
+		t.Fatalf("AddDeployKey: %v", err)
+	}
+	if key.ID != 7 || !key.ReadOnly {
+		t.Fatalf("key = %+v", key)
+	}
+	if gotBody.Title != "deploy" || gotBody.Key != "ssh-ed25519 AAAA" || !gotBody.ReadOnly {
+		t.Errorf("gotBody = %+v", gotBody)
+	}
+}
+
+func TestListDeployKeysDecodesResponse(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`[{"id": 1, "title": "ci", "key": "ssh-rsa AAAA", "read_only": false}]`))
+	}))
+	defer server.Close()
+
+	client, err := NewClient("", t.TempDir(), 0, "")
+	if err != nil {
+		t.Fatalf("NewClient: %v", err)
+	}
+	client.BaseURL = server.URL
+
+	keys, err := client.ListDeployKeys("acme", "widgets")
+	if err != nil {
+		t.Fatalf("ListDeployKeys: %v", err)
+	}
+	if len(keys) != 1 || keys[0].Title != "ci" {
+		t.Fatalf("keys = %+v", keys)
+	}
+}
+
+func TestDeleteDeployKeySendsDelete(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodDelete {
+			t.Errorf("method = %s, want DELETE", r.Method)
+		}
+		if r.URL.Path != "/repos/acme/widgets/keys/7" {
+			t.Errorf("path = %s", r.URL.Path)
+		}
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer server.Close()
+
+	client, err := NewClient("", t.TempDir(), 0, "")
+	if err != nil {
+		t.Fatalf("NewClient: %v", err)
+	}
+	client.BaseURL = server.URL
+
+	if err := client.DeleteDeployKey("acme", "widgets", 7); err != nil {
+		t.Fatalf("DeleteDeployKey: %v", err)
+	}
+}
@@ -0,0 +1,33 @@
+package github
+
+import (
+	"fmt"
+	"net/http"
+)
+
+// APIError is a non-2xx response from the GitHub API. StatusCode and
+// RateLimited let callers (notably core.FromAPIError) map it onto a typed,
+// hint-bearing error without parsing Error()'s text.
+type APIError struct {
+	StatusCode  int
+	Message     string
+	RateLimited bool
+}
+
+func (e *APIError) Error() string {
+	return fmt.Sprintf("github: %d: %s", e.StatusCode, e.Message)
+}
+
+// handleResponse turns a non-2xx response into a typed *APIError, detecting
+// GitHub's rate-limit signal (403 with X-RateLimit-Remaining: 0) so callers
+// can distinguish it from a plain permissions error.
+func handleResponse(resp *http.Response, body []byte) error {
+	if resp.StatusCode >= 200 && resp.StatusCode < 300 {
+		return nil
+	}
+	return &APIError{
+		StatusCode:  resp.StatusCode,
+		Message:     string(body),
+		RateLimited: resp.StatusCode == http.StatusForbidden && resp.Header.Get("X-RateLimit-Remaining") == "0",
+	}
+}
@@ -0,0 +1,23 @@
+package github
+
+import "encoding/json"
+
+// forkRepoRequest is the POST body for ForkRepo. Organization is omitted
+// when empty, forking into the authenticated user's account.
+type forkRepoRequest struct {
+	Organization string `json:"organization,omitempty"`
+}
+
+// ForkRepo forks owner/repo into org (or the authenticated user's account,
+// if org is empty).
+func (c *Client) ForkRepo(owner, repo, org string) (*Repo, error) {
+	body, err := c.post("/repos/"+owner+"/"+repo+"/forks", forkRepoRequest{Organization: org})
+	if err != nil {
+		return nil, err
+	}
+	var fork Repo
+	if err := json.Unmarshal(body, &fork); err != nil {
+		return nil, err
+	}
+	return &fork, nil
+}
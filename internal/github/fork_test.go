@@ -0,0 +1,77 @@
+package github
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestForkRepoSendsExpectedBody(t *testing.T) {
+	var gotBody forkRepoRequest
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost {
+			t.Errorf("method = %s, want POST", r.Method)
+		}
+		if r.URL.Path != "/repos/acme/widgets/forks" {
+			t.Errorf("path = %s", r.URL.Path)
+		}
+		data, err := io.ReadAll(r.Body)
+		if err != nil {
+			t.Fatalf("reading body: %v", err)
+		}
+		if err := json.Unmarshal(data, &gotBody); err != nil {
+			t.Fatalf("unmarshaling body: %v", err)
+		}
+		w.WriteHeader(http.StatusAccepted)
+		w.Write([]byte(`{"name": "widgets", "full_name": "myorg/widgets", "clone_url": "https://github.com/myorg/widgets.git", "html_url": "https://github.com/myorg/widgets"}`))
+	}))
+	defer server.Close()
+
+	client, err := NewClient("", t.TempDir(), 0, "")
+	if err != nil {
+		t.Fatalf("NewClient: %v", err)
+	}
+	client.BaseURL = server.URL
+
+	fork, err := client.ForkRepo("acme", "widgets", "myorg")
+	if err != nil {
+		t.Fatalf("ForkRepo: %v", err)
+	}
+	if fork.FullName != "myorg/widgets" {
+		t.Errorf("fork = %+v", fork)
+	}
+	if gotBody.Organization != "myorg" {
+		t.Errorf("gotBody = %+v", gotBody)
+	}
+}
+
+func TestForkRepoOmitsEmptyOrganization(t *testing.T) {
+	var gotBody map[string]any
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		data, err := io.ReadAll(r.Body)
+		if err != nil {
+			t.Fatalf("reading body: %v", err)
+		}
+		if err := json.Unmarshal(data, &gotBody); err != nil {
+			t.Fatalf("unmarshaling body: %v", err)
+		}
+		w.WriteHeader(http.StatusAccepted)
+		w.Write([]byte(`{"name": "widgets", "full_name": "me/widgets"}`))
+	}))
+	defer server.Close()
+
+	client, err := NewClient("", t.TempDir(), 0, "")
+	if err != nil {
+		t.Fatalf("NewClient: %v", err)
+	}
+	client.BaseURL = server.URL
+
+	if _, err := client.ForkRepo("acme", "widgets", ""); err != nil {
+		t.Fatalf("ForkRepo: %v", err)
+	}
+	if _, ok := gotBody["organization"]; ok {
+		t.Errorf("gotBody = %+v, expected no organization key", gotBody)
+	}
+}
@@ -0,0 +1,66 @@
+package github
+
+import "encoding/json"
+
+// Gist is the subset of GitHub's gist object arngit needs.
+type Gist struct {
+	ID          string              `json:"id"`
+	Description string              `json:"description"`
+	Public      bool                `json:"public"`
+	HTMLURL     string              `json:"html_url"`
+	Files       map[string]GistFile `json:"files"`
+}
+
+// GistFile is one file within a gist.
+type GistFile struct {
+	Content string `json:"content"`
+}
+
+// createGistRequest is the POST body for CreateGist.
+type createGistRequest struct {
+	Description string              `json:"description"`
+	Public      bool                `json:"public"`
+	Files       map[string]GistFile `json:"files"`
+}
+
+// CreateGist creates a gist from files (name -> content), visible publicly
+// if public is set.
+func (c *Client) CreateGist(files map[string]string, public bool, description string) (*Gist, error) {
+	gistFiles := make(map[string]GistFile, len(files))
+	for name, content := range files {
+		gistFiles[name] = GistFile{Content: content}
+	}
+
+	body, err := c.post("/gists", createGistRequest{
+		Description: description,
+		Public:      public,
+		Files:       gistFiles,
+	})
+	if err != nil {
+		return nil, err
+	}
+	var gist Gist
+	if err := json.Unmarshal(body, &gist); err != nil {
+		return nil, err
+	}
+	return &gist, nil
+}
+
+// ListGists returns the authenticated user's gists.
+func (c *Client) ListGists() ([]Gist, error) {
+	var gists []Gist
+	err := c.getAllPages("/gists", func(page []byte) error {
+		var batch []Gist
+		if err := json.Unmarshal(page, &batch); err != nil {
+			return err
+		}
+		gists = append(gists, batch...)
+		return nil
+	})
+	return gists, err
+}
+
+// DeleteGist removes the gist identified by id.
+func (c *Client) DeleteGist(id string) error {
+	return c.delete("/gists/" + id)
+}
@@ -0,0 +1,98 @@
+package github
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestCreateGistSendsExpectedBodyWithMultipleFiles(t *testing.T) {
+	var gotBody createGistRequest
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost {
+			t.Errorf("method = %s, want POST", r.Method)
+		}
+		if r.URL.Path != "/gists" {
+			t.Errorf("path = %s", r.URL.Path)
+		}
+		data, err := io.ReadAll(r.Body)
+		if err != nil {
+			t.Fatalf("reading body: %v", err)
+		}
+		if err := json.Unmarshal(data, &gotBody); err != nil {
+			t.Fatalf("unmarshaling body: %v", err)
+		}
+		w.WriteHeader(http.StatusCreated)
+		w.Write([]byte(`{"id": "abc123", "description": "notes", "public": false, "html_url": "https://gist.github.com/abc123"}`))
+	}))
+	defer server.Close()
+
+	client, err := NewClient("", t.TempDir(), 0, "")
+	if err != nil {
+		t.Fatalf("NewClient: %v", err)
+	}
+	client.BaseURL = server.URL
+
+	gist, err := client.CreateGist(map[string]string{
+		"a.txt": "hello",
+		"b.txt": "world",
+	}, false, "notes")
+	if err != nil {
+		t.Fatalf("CreateGist: %v", err)
+	}
+	if gist.ID != "abc123" {
+		t.Errorf("gist = %+v", gist)
+	}
+	if len(gotBody.Files) != 2 || gotBody.Files["a.txt"].Content != "hello" || gotBody.Files["b.txt"].Content != "world" {
+		t.Errorf("gotBody.Files = %+v", gotBody.Files)
+	}
+	if gotBody.Description != "notes" || gotBody.Public {
+		t.Errorf("gotBody = %+v", gotBody)
+	}
+}
+
+func TestListGistsDecodesResponse(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`[{"id": "abc123", "description": "notes", "public": true}]`))
+	}))
+	defer server.Close()
+
+	client, err := NewClient("", t.TempDir(), 0, "")
+	if err != nil {
+		t.Fatalf("NewClient: %v", err)
+	}
+	client.BaseURL = server.URL
+
+	gists, err := client.ListGists()
+	if err != nil {
+		t.Fatalf("ListGists: %v", err)
+	}
+	if len(gists) != 1 || gists[0].ID != "abc123" {
+		t.Fatalf("gists = %+v", gists)
+	}
+}
+
+func TestDeleteGistSendsDelete(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodDelete {
+			t.Errorf("method = %s, want DELETE", r.Method)
+		}
+		if r.URL.Path != "/gists/abc123" {
+			t.Errorf("path = %s", r.URL.Path)
+		}
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer server.Close()
+
+	client, err := NewClient("", t.TempDir(), 0, "")
+	if err != nil {
+		t.Fatalf("NewClient: %v", err)
+	}
+	client.BaseURL = server.URL
+
+	if err := client.DeleteGist("abc123"); err != nil {
+		t.Fatalf("DeleteGist: %v", err)
+	}
+}
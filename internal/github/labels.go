@@ -0,0 +1,80 @@
+package github
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/url"
+)
+
+// Label is the subset of GitHub's label object arngit needs.
+type Label struct {
+	Name        string `json:"name"`
+	Color       string `json:"color"`
+	Description string `json:"description,omitempty"`
+}
+
+// CreateLabelRequest is the POST body for CreateLabel.
+type CreateLabelRequest struct {
+	Name        string `json:"name"`
+	Color       string `json:"color"`
+	Description string `json:"description,omitempty"`
+}
+
+type addLabelsRequest struct {
+	Labels []string `json:"labels"`
+}
+
+func labelsPath(owner, repo string) string {
+	return fmt.Sprintf("/repos/%s/%s/labels", owner, repo)
+}
+
+func issueLabelsPath(owner, repo string, number int) string {
+	return fmt.Sprintf("/repos/%s/%s/issues/%d/labels", owner, repo, number)
+}
+
+// ListLabels lists the labels defined on owner/repo.
+func (c *Client) ListLabels(owner, repo string) ([]Label, error) {
+	var labels []Label
+	err := c.getAllPages(labelsPath(owner, repo), func(page []byte) error {
+		var batch []Label
+		if err := json.Unmarshal(page, &batch); err != nil {
+			return err
+		}
+		labels = append(labels, batch...)
+		return nil
+	})
+	return labels, err
+}
+
+// CreateLabel defines a new label on owner/repo.
+func (c *Client) CreateLabel(owner, repo string, req CreateLabelRequest) (*Label, error) {
+	body, err := c.post(labelsPath(owner, repo), req)
+	if err != nil {
+		return nil, err
+	}
+	var label Label
+	if err := json.Unmarshal(body, &label); err != nil {
+		return nil, err
+	}
+	return &label, nil
+}
+
+// AddLabelsToIssue applies labels to the issue or pull request numbered
+// number on owner/repo, returning the issue's full label set.
+func (c *Client) AddLabelsToIssue(owner, repo string, number int, labels []string) ([]Label, error) {
+	body, err := c.post(issueLabelsPath(owner, repo, number), addLabelsRequest{Labels: labels})
+	if err != nil {
+		return nil, err
+	}
+	var result []Label
+	if err := json.Unmarshal(body, &result); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// RemoveLabel removes a single label from the issue or pull request
+// numbered number on owner/repo.
+func (c *Client) RemoveLabel(owner, repo string, number int, name string) error {
+	return c.delete(fmt.Sprintf("%s/%s", issueLabelsPath(owner, repo, number), url.PathEscape(name)))
+}
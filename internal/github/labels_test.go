@@ -0,0 +1,130 @@
+package github
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestListLabelsDecodesResponse(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`[{"name": "bug", "color": "d73a4a"}]`))
+	}))
+	defer server.Close()
+
+	client, err := NewClient("", t.TempDir(), 0, "")
+	if err != nil {
+		t.Fatalf("NewClient: %v", err)
+	}
+	client.BaseURL = server.URL
+
+	labels, err := client.ListLabels("acme", "widgets")
+	if err != nil {
+		t.Fatalf("ListLabels: %v", err)
+	}
+	if len(labels) != 1 || labels[0].Name != "bug" {
+		t.Fatalf("labels = %+v", labels)
+	}
+}
+
+func TestCreateLabelSendsExpectedBody(t *testing.T) {
+	var gotBody CreateLabelRequest
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost {
+			t.Errorf("method = %s, want POST", r.Method)
+		}
+		if r.URL.Path != "/repos/acme/widgets/labels" {
+			t.Errorf("path = %s", r.URL.Path)
+		}
+		data, err := io.ReadAll(r.Body)
+		if err != nil {
+			t.Fatalf("reading body: %v", err)
+		}
+		if err := json.Unmarshal(data, &gotBody); err != nil {
+			t.Fatalf("unmarshaling body: %v", err)
+		}
+		w.WriteHeader(http.StatusCreated)
+		w.Write([]byte(`{"name": "priority", "color": "ffcc00"}`))
+	}))
+	defer server.Close()
+
+	client, err := NewClient("", t.TempDir(), 0, "")
+	if err != nil {
+		t.Fatalf("NewClient: %v", err)
+	}
+	client.BaseURL = server.URL
+
+	label, err := client.CreateLabel("acme", "widgets", CreateLabelRequest{Name: "priority", Color: "ffcc00"})
+	if err != nil {
+		t.Fatalf("CreateLabel: %v", err)
+	}
+	if label.Name != "priority" {
+		t.Errorf("label = %+v", label)
+	}
+	if gotBody.Name != "priority" || gotBody.Color != "ffcc00" {
+		t.Errorf("gotBody = %+v", gotBody)
+	}
+}
+
+func TestAddLabelsToIssueSendsExpectedBody(t *testing.T) {
+	var gotBody addLabelsRequest
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost {
+			t.Errorf("method = %s, want POST", r.Method)
+		}
+		if r.URL.Path != "/repos/acme/widgets/issues/5/labels" {
+			t.Errorf("path = %s", r.URL.Path)
+		}
+		data, err := io.ReadAll(r.Body)
+		if err != nil {
+			t.Fatalf("reading body: %v", err)
+		}
+		if err := json.Unmarshal(data, &gotBody); err != nil {
+			t.Fatalf("unmarshaling body: %v", err)
+		}
+		w.Write([]byte(`[{"name": "bug", "color": "d73a4a"}, {"name": "priority", "color": "ffcc00"}]`))
+	}))
+	defer server.Close()
+
+	client, err := NewClient("", t.TempDir(), 0, "")
+	if err != nil {
+		t.Fatalf("NewClient: %v", err)
+	}
+	client.BaseURL = server.URL
+
+	labels, err := client.AddLabelsToIssue("acme", "widgets", 5, []string{"bug", "priority"})
+	if err != nil {
+		t.Fatalf("AddLabelsToIssue: %v", err)
+	}
+	if len(labels) != 2 {
+		t.Fatalf("labels = %+v", labels)
+	}
+	if len(gotBody.Labels) != 2 || gotBody.Labels[0] != "bug" || gotBody.Labels[1] != "priority" {
+		t.Errorf("gotBody = %+v", gotBody)
+	}
+}
+
+func TestRemoveLabelSendsDelete(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodDelete {
+			t.Errorf("method = %s, want DELETE", r.Method)
+		}
+		if r.URL.Path != "/repos/acme/widgets/issues/5/labels/needs-triage" {
+			t.Errorf("path = %s", r.URL.Path)
+		}
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	client, err := NewClient("", t.TempDir(), 0, "")
+	if err != nil {
+		t.Fatalf("NewClient: %v", err)
+	}
+	client.BaseURL = server.URL
+
+	if err := client.RemoveLabel("acme", "widgets", 5, "needs-triage"); err != nil {
+		t.Fatalf("RemoveLabel: %v", err)
+	}
+}
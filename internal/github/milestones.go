@@ -0,0 +1,76 @@
+package github
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+// Milestone is the subset of GitHub's milestone object arngit needs.
+type Milestone struct {
+	Number      int    `json:"number"`
+	Title       string `json:"title"`
+	Description string `json:"description,omitempty"`
+	State       string `json:"state"`
+	DueOn       string `json:"due_on,omitempty"`
+}
+
+// CreateMilestoneRequest is the POST body for CreateMilestone. DueOn, if
+// set, must already be an RFC 3339 timestamp.
+type CreateMilestoneRequest struct {
+	Title       string `json:"title"`
+	Description string `json:"description,omitempty"`
+	DueOn       string `json:"due_on,omitempty"`
+}
+
+type updateMilestoneStateRequest struct {
+	State string `json:"state"`
+}
+
+type setMilestoneRequest struct {
+	Milestone int `json:"milestone"`
+}
+
+func milestonesPath(owner, repo string) string {
+	return fmt.Sprintf("/repos/%s/%s/milestones", owner, repo)
+}
+
+// ListMilestones lists the open milestones on owner/repo.
+func (c *Client) ListMilestones(owner, repo string) ([]Milestone, error) {
+	var milestones []Milestone
+	err := c.getAllPages(milestonesPath(owner, repo), func(page []byte) error {
+		var batch []Milestone
+		if err := json.Unmarshal(page, &batch); err != nil {
+			return err
+		}
+		milestones = append(milestones, batch...)
+		return nil
+	})
+	return milestones, err
+}
+
+// CreateMilestone opens a new milestone on owner/repo.
+func (c *Client) CreateMilestone(owner, repo string, req CreateMilestoneRequest) (*Milestone, error) {
+	body, err := c.post(milestonesPath(owner, repo), req)
+	if err != nil {
+		return nil, err
+	}
+	var milestone Milestone
+	if err := json.Unmarshal(body, &milestone); err != nil {
+		return nil, err
+	}
+	return &milestone, nil
+}
+
+// CloseMilestone marks the milestone numbered number as closed.
+func (c *Client) CloseMilestone(owner, repo string, number int) error {
+	_, err := c.patch(fmt.Sprintf("%s/%d", milestonesPath(owner, repo), number), updateMilestoneStateRequest{State: "closed"})
+	return err
+}
+
+// SetMilestone assigns the milestone numbered milestoneNumber to the issue
+// or pull request numbered number, since GitHub tracks pull requests as
+// issues for this purpose.
+func (c *Client) SetMilestone(owner, repo string, number, milestoneNumber int) error {
+	_, err := c.patch(fmt.Sprintf("/repos/%s/%s/issues/%d", owner, repo, number), setMilestoneRequest{Milestone: milestoneNumber})
+	return err
+}
@@ -0,0 +1,140 @@
+package github
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestListMilestonesDecodesResponse(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`[{"number": 1, "title": "v1.0", "state": "open"}]`))
+	}))
+	defer server.Close()
+
+	client, err := NewClient("", t.TempDir(), 0, "")
+	if err != nil {
+		t.Fatalf("NewClient: %v", err)
+	}
+	client.BaseURL = server.URL
+
+	milestones, err := client.ListMilestones("acme", "widgets")
+	if err != nil {
+		t.Fatalf("ListMilestones: %v", err)
+	}
+	if len(milestones) != 1 || milestones[0].Title != "v1.0" {
+		t.Fatalf("milestones = %+v", milestones)
+	}
+}
+
+func TestCreateMilestoneSendsExpectedBody(t *testing.T) {
+	var gotBody CreateMilestoneRequest
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost {
+			t.Errorf("method = %s, want POST", r.Method)
+		}
+		if r.URL.Path != "/repos/acme/widgets/milestones" {
+			t.Errorf("path = %s", r.URL.Path)
+		}
+		data, err := io.ReadAll(r.Body)
+		if err != nil {
+			t.Fatalf("reading body: %v", err)
+		}
+		if err := json.Unmarshal(data, &gotBody); err != nil {
+			t.Fatalf("unmarshaling body: %v", err)
+		}
+		w.WriteHeader(http.StatusCreated)
+		w.Write([]byte(`{"number": 2, "title": "v2.0", "state": "open", "due_on": "2026-09-01T00:00:00Z"}`))
+	}))
+	defer server.Close()
+
+	client, err := NewClient("", t.TempDir(), 0, "")
+	if err != nil {
+		t.Fatalf("NewClient: %v", err)
+	}
+	client.BaseURL = server.URL
+
+	milestone, err := client.CreateMilestone("acme", "widgets", CreateMilestoneRequest{
+		Title: "v2.0",
+		DueOn: "2026-09-01T00:00:00Z",
+	})
+	if err != nil {
+		t.Fatalf("CreateMilestone: %v", err)
+	}
+	if milestone.Number != 2 || milestone.DueOn != "2026-09-01T00:00:00Z" {
+		t.Fatalf("milestone = %+v", milestone)
+	}
+	if gotBody.Title != "v2.0" || gotBody.DueOn != "2026-09-01T00:00:00Z" {
+		t.Errorf("gotBody = %+v", gotBody)
+	}
+}
+
+func TestCloseMilestoneSendsExpectedBody(t *testing.T) {
+	var gotBody updateMilestoneStateRequest
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPatch {
+			t.Errorf("method = %s, want PATCH", r.Method)
+		}
+		if r.URL.Path != "/repos/acme/widgets/milestones/2" {
+			t.Errorf("path = %s", r.URL.Path)
+		}
+		data, err := io.ReadAll(r.Body)
+		if err != nil {
+			t.Fatalf("reading body: %v", err)
+		}
+		if err := json.Unmarshal(data, &gotBody); err != nil {
+			t.Fatalf("unmarshaling body: %v", err)
+		}
+		w.Write([]byte(`{"number": 2, "title": "v2.0", "state": "closed"}`))
+	}))
+	defer server.Close()
+
+	client, err := NewClient("", t.TempDir(), 0, "")
+	if err != nil {
+		t.Fatalf("NewClient: %v", err)
+	}
+	client.BaseURL = server.URL
+
+	if err := client.CloseMilestone("acme", "widgets", 2); err != nil {
+		t.Fatalf("CloseMilestone: %v", err)
+	}
+	if gotBody.State != "closed" {
+		t.Errorf("gotBody = %+v", gotBody)
+	}
+}
+
+func TestSetMilestoneSendsExpectedBody(t *testing.T) {
+	var gotBody setMilestoneRequest
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPatch {
+			t.Errorf("method = %s, want PATCH", r.Method)
+		}
+		if r.URL.Path != "/repos/acme/widgets/issues/7" {
+			t.Errorf("path = %s", r.URL.Path)
+		}
+		data, err := io.ReadAll(r.Body)
+		if err != nil {
+			t.Fatalf("reading body: %v", err)
+		}
+		if err := json.Unmarshal(data, &gotBody); err != nil {
+			t.Fatalf("unmarshaling body: %v", err)
+		}
+		w.Write([]byte(`{}`))
+	}))
+	defer server.Close()
+
+	client, err := NewClient("", t.TempDir(), 0, "")
+	if err != nil {
+		t.Fatalf("NewClient: %v", err)
+	}
+	client.BaseURL = server.URL
+
+	if err := client.SetMilestone("acme", "widgets", 7, 2); err != nil {
+		t.Fatalf("SetMilestone: %v", err)
+	}
+	if gotBody.Milestone != 2 {
+		t.Errorf("gotBody = %+v", gotBody)
+	}
+}
@@ -0,0 +1,93 @@
+package github
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestListNotificationsDecodesResponseAndHeaders(t *testing.T) {
+	var gotQuery string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/notifications" {
+			t.Errorf("path = %s", r.URL.Path)
+		}
+		gotQuery = r.URL.RawQuery
+		w.Header().Set("X-Poll-Interval", "60")
+		w.Header().Set("Last-Modified", "Mon, 01 Jan 2024 00:00:00 GMT")
+		w.Write([]byte(`[{"id": "1", "unread": true, "reason": "mention", "subject": {"title": "Fix the bug", "type": "Issue"}, "repository": {"full_name": "acme/widgets"}}]`))
+	}))
+	defer server.Close()
+
+	client, err := NewClient("", t.TempDir(), 0, "")
+	if err != nil {
+		t.Fatalf("NewClient: %v", err)
+	}
+	client.BaseURL = server.URL
+
+	result, err := client.ListNotifications(true)
+	if err != nil {
+		t.Fatalf("ListNotifications: %v", err)
+	}
+	if gotQuery != "all=true" {
+		t.Errorf("query = %q", gotQuery)
+	}
+	if len(result.Notifications) != 1 {
+		t.Fatalf("notifications = %+v", result.Notifications)
+	}
+	n := result.Notifications[0]
+	if n.Reason != "mention" || n.Repository.FullName != "acme/widgets" || n.Subject.Title != "Fix the bug" {
+		t.Errorf("notification = %+v", n)
+	}
+	if result.PollInterval != 60 {
+		t.Errorf("PollInterval = %d, want 60", result.PollInterval)
+	}
+	if result.LastModified == "" {
+		t.Errorf("LastModified is empty")
+	}
+}
+
+func TestListNotificationsOmitsAllWhenFalse(t *testing.T) {
+	var gotQuery string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		gotQuery = r.URL.RawQuery
+		w.Write([]byte(`[]`))
+	}))
+	defer server.Close()
+
+	client, err := NewClient("", t.TempDir(), 0, "")
+	if err != nil {
+		t.Fatalf("NewClient: %v", err)
+	}
+	client.BaseURL = server.URL
+
+	if _, err := client.ListNotifications(false); err != nil {
+		t.Fatalf("ListNotifications: %v", err)
+	}
+	if gotQuery != "" {
+		t.Errorf("query = %q, want empty", gotQuery)
+	}
+}
+
+func TestMarkNotificationReadSendsPatch(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPatch {
+			t.Errorf("method = %s, want PATCH", r.Method)
+		}
+		if r.URL.Path != "/notifications/threads/123" {
+			t.Errorf("path = %s", r.URL.Path)
+		}
+		w.WriteHeader(http.StatusResetContent)
+	}))
+	defer server.Close()
+
+	client, err := NewClient("", t.TempDir(), 0, "")
+	if err != nil {
+		t.Fatalf("NewClient: %v", err)
+	}
+	client.BaseURL = server.URL
+
+	if err := client.MarkNotificationRead("123"); err != nil {
+		t.Fatalf("MarkNotificationRead: %v", err)
+	}
+}
@@ -0,0 +1,62 @@
+package github
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestListOrgReposFollowsPagination(t *testing.T) {
+	var server *httptest.Server
+	requests := 0
+	server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		switch {
+		case r.URL.Path == "/orgs/acme/repos" && r.URL.RawQuery == "":
+			w.Header().Set("Link", fmt.Sprintf(`<%s/orgs/acme/repos?page=2>; rel="next"`, server.URL))
+			w.Write([]byte(`[{"name":"one"}]`))
+		default:
+			w.Write([]byte(`[{"name":"two"}]`))
+		}
+	}))
+	defer server.Close()
+
+	client, err := NewClient("", t.TempDir(), 0, "")
+	if err != nil {
+		t.Fatalf("NewClient: %v", err)
+	}
+	client.BaseURL = server.URL
+
+	repos, err := client.ListOrgRepos("acme")
+	if err != nil {
+		t.Fatalf("ListOrgRepos: %v", err)
+	}
+
+	if requests != 2 {
+		t.Fatalf("requests = %d, want 2", requests)
+	}
+	if len(repos) != 2 || repos[0].Name != "one" || repos[1].Name != "two" {
+		t.Fatalf("repos = %+v, want [one, two]", repos)
+	}
+}
+
+func TestNextPageURL(t *testing.T) {
+	tests := []struct {
+		name   string
+		header string
+		want   string
+	}{
+		{"empty", "", ""},
+		{"no next", `<https://api.github.com/x?page=1>; rel="last"`, ""},
+		{"next present", `<https://api.github.com/x?page=2>; rel="next", <https://api.github.com/x?page=3>; rel="last"`, "https://api.github.com/x?page=2"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := nextPageURL(tt.header); got != tt.want {
+				t.Errorf("nextPageURL(%q) = %q, want %q", tt.header, got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,69 @@
+package github
+
+import (
+	"encoding/json"
+	"net/url"
+)
+
+// PullRequest is the subset of GitHub's pull request object arngit needs.
+type PullRequest struct {
+	Number  int    `json:"number"`
+	Title   string `json:"title"`
+	State   string `json:"state"`
+	HTMLURL string `json:"html_url"`
+}
+
+// ListPRs returns every pull request (of any state) on owner/repo.
+func (c *Client) ListPRs(owner, repo string) ([]PullRequest, error) {
+	var prs []PullRequest
+	err := c.getAllPages("/repos/"+owner+"/"+repo+"/pulls?state=all", func(page []byte) error {
+		var batch []PullRequest
+		if err := json.Unmarshal(page, &batch); err != nil {
+			return err
+		}
+		prs = append(prs, batch...)
+		return nil
+	})
+	return prs, err
+}
+
+// ListPRsForHead returns the open pull requests on owner/repo whose head is
+// owner:head, so callers can detect one already exists before opening a
+// duplicate.
+func (c *Client) ListPRsForHead(owner, repo, head string) ([]PullRequest, error) {
+	query := url.Values{}
+	query.Set("head", owner+":"+head)
+	query.Set("state", "open")
+
+	var prs []PullRequest
+	err := c.getAllPages("/repos/"+owner+"/"+repo+"/pulls?"+query.Encode(), func(page []byte) error {
+		var batch []PullRequest
+		if err := json.Unmarshal(page, &batch); err != nil {
+			return err
+		}
+		prs = append(prs, batch...)
+		return nil
+	})
+	return prs, err
+}
+
+// CreatePRRequest is the POST body for CreatePR.
+type CreatePRRequest struct {
+	Title string `json:"title"`
+	Head  string `json:"head"`
+	Base  string `json:"base"`
+	Body  string `json:"body,omitempty"`
+}
+
+// CreatePR opens a pull request on owner/repo.
+func (c *Client) CreatePR(owner, repo string, req CreatePRRequest) (*PullRequest, error) {
+	body, err := c.post("/repos/"+owner+"/"+repo+"/pulls", req)
+	if err != nil {
+		return nil, err
+	}
+	var pr PullRequest
+	if err := json.Unmarshal(body, &pr); err != nil {
+		return nil, err
+	}
+	return &pr, nil
+}
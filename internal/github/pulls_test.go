@@ -0,0 +1,102 @@
+package github
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestListPRsForHeadSendsExpectedQuery(t *testing.T) {
+	var gotQuery string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/repos/acme/widgets/pulls" {
+			t.Errorf("path = %s", r.URL.Path)
+		}
+		gotQuery = r.URL.RawQuery
+		w.Write([]byte(`[{"number": 5, "title": "add feature", "state": "open", "html_url": "https://github.com/acme/widgets/pull/5"}]`))
+	}))
+	defer server.Close()
+
+	client, err := NewClient("", t.TempDir(), 0, "")
+	if err != nil {
+		t.Fatalf("NewClient: %v", err)
+	}
+	client.BaseURL = server.URL
+
+	prs, err := client.ListPRsForHead("acme", "widgets", "my-feature")
+	if err != nil {
+		t.Fatalf("ListPRsForHead: %v", err)
+	}
+	if len(prs) != 1 || prs[0].Number != 5 {
+		t.Fatalf("prs = %+v", prs)
+	}
+	if gotQuery != "head=acme%3Amy-feature&state=open" {
+		t.Errorf("query = %q", gotQuery)
+	}
+}
+
+func TestListPRsForHeadReturnsEmptyWhenNoneOpen(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`[]`))
+	}))
+	defer server.Close()
+
+	client, err := NewClient("", t.TempDir(), 0, "")
+	if err != nil {
+		t.Fatalf("NewClient: %v", err)
+	}
+	client.BaseURL = server.URL
+
+	prs, err := client.ListPRsForHead("acme", "widgets", "my-feature")
+	if err != nil {
+		t.Fatalf("ListPRsForHead: %v", err)
+	}
+	if len(prs) != 0 {
+		t.Fatalf("prs = %+v, want empty", prs)
+	}
+}
+
+func TestCreatePRSendsExpectedBody(t *testing.T) {
+	var gotBody CreatePRRequest
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost {
+			t.Errorf("method = %s, want POST", r.Method)
+		}
+		if r.URL.Path != "/repos/acme/widgets/pulls" {
+			t.Errorf("path = %s", r.URL.Path)
+		}
+		data, err := io.ReadAll(r.Body)
+		if err != nil {
+			t.Fatalf("reading body: %v", err)
+		}
+		if err := json.Unmarshal(data, &gotBody); err != nil {
+			t.Fatalf("unmarshaling body: %v", err)
+		}
+		w.WriteHeader(http.StatusCreated)
+		w.Write([]byte(`{"number": 9, "title": "add feature", "state": "open", "html_url": "https://github.com/acme/widgets/pull/9"}`))
+	}))
+	defer server.Close()
+
+	client, err := NewClient("", t.TempDir(), 0, "")
+	if err != nil {
+		t.Fatalf("NewClient: %v", err)
+	}
+	client.BaseURL = server.URL
+
+	pr, err := client.CreatePR("acme", "widgets", CreatePRRequest{
+		Title: "add feature",
+		Head:  "my-feature",
+		Base:  "main",
+	})
+	if err != nil {
+		t.Fatalf("CreatePR: %v", err)
+	}
+	if pr.Number != 9 {
+		t.Errorf("pr = %+v", pr)
+	}
+	if gotBody.Head != "my-feature" || gotBody.Base != "main" {
+		t.Errorf("gotBody = %+v", gotBody)
+	}
+}
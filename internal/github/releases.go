@@ -0,0 +1,31 @@
+package github
+
+import "encoding/json"
+
+// ReleaseAsset is one file attached to a release.
+type ReleaseAsset struct {
+	Name               string `json:"name"`
+	BrowserDownloadURL string `json:"browser_download_url"`
+}
+
+// Release is the subset of GitHub's release object arngit needs.
+type Release struct {
+	TagName    string         `json:"tag_name"`
+	Name       string         `json:"name"`
+	Prerelease bool           `json:"prerelease"`
+	Assets     []ReleaseAsset `json:"assets"`
+}
+
+// ListReleases returns every release published on owner/repo, newest first.
+func (c *Client) ListReleases(owner, repo string) ([]Release, error) {
+	var releases []Release
+	err := c.getAllPages("/repos/"+owner+"/"+repo+"/releases", func(page []byte) error {
+		var batch []Release
+		if err := json.Unmarshal(page, &batch); err != nil {
+			return err
+		}
+		releases = append(releases, batch...)
+		return nil
+	})
+	return releases, err
+}
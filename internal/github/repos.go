@@ -0,0 +1,40 @@
+package github
+
+import "encoding/json"
+
+// Repo is the subset of GitHub's repository object arngit needs.
+type Repo struct {
+	Name     string `json:"name"`
+	FullName string `json:"full_name"`
+	CloneURL string `json:"clone_url"`
+	SSHURL   string `json:"ssh_url"`
+	HTMLURL  string `json:"html_url"`
+}
+
+// ListOrgRepos returns every repository in org.
+func (c *Client) ListOrgRepos(org string) ([]Repo, error) {
+	var repos []Repo
+	err := c.getAllPages("/orgs/"+org+"/repos", func(page []byte) error {
+		var batch []Repo
+		if err := json.Unmarshal(page, &batch); err != nil {
+			return err
+		}
+		repos = append(repos, batch...)
+		return nil
+	})
+	return repos, err
+}
+
+// ListUserRepos returns every repository owned by user.
+func (c *Client) ListUserRepos(user string) ([]Repo, error) {
+	var repos []Repo
+	err := c.getAllPages("/users/"+user+"/repos", func(page []byte) error {
+		var batch []Repo
+		if err := json.Unmarshal(page, &batch); err != nil {
+			return err
+		}
+		repos = append(repos, batch...)
+		return nil
+	})
+	return repos, err
+}
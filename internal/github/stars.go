@@ -0,0 +1,32 @@
+package github
+
+import "encoding/json"
+
+func starPath(owner, repo string) string {
+	return "/user/starred/" + owner + "/" + repo
+}
+
+// StarRepo stars owner/repo for the authenticated user.
+func (c *Client) StarRepo(owner, repo string) error {
+	_, err := c.put(starPath(owner, repo), nil)
+	return err
+}
+
+// UnstarRepo unstars owner/repo for the authenticated user.
+func (c *Client) UnstarRepo(owner, repo string) error {
+	return c.delete(starPath(owner, repo))
+}
+
+// ListStarred returns every repository the authenticated user has starred.
+func (c *Client) ListStarred() ([]Repo, error) {
+	var repos []Repo
+	err := c.getAllPages("/user/starred", func(page []byte) error {
+		var batch []Repo
+		if err := json.Unmarshal(page, &batch); err != nil {
+			return err
+		}
+		repos = append(repos, batch...)
+		return nil
+	})
+	return repos, err
+}
@@ -0,0 +1,77 @@
+package github
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestStarRepoSendsPut(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPut {
+			t.Errorf("method = %s, want PUT", r.Method)
+		}
+		if r.URL.Path != "/user/starred/acme/widgets" {
+			t.Errorf("path = %s", r.URL.Path)
+		}
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer server.Close()
+
+	client, err := NewClient("", t.TempDir(), 0, "")
+	if err != nil {
+		t.Fatalf("NewClient: %v", err)
+	}
+	client.BaseURL = server.URL
+
+	if err := client.StarRepo("acme", "widgets"); err != nil {
+		t.Fatalf("StarRepo: %v", err)
+	}
+}
+
+func TestUnstarRepoSendsDelete(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodDelete {
+			t.Errorf("method = %s, want DELETE", r.Method)
+		}
+		if r.URL.Path != "/user/starred/acme/widgets" {
+			t.Errorf("path = %s", r.URL.Path)
+		}
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer server.Close()
+
+	client, err := NewClient("", t.TempDir(), 0, "")
+	if err != nil {
+		t.Fatalf("NewClient: %v", err)
+	}
+	client.BaseURL = server.URL
+
+	if err := client.UnstarRepo("acme", "widgets"); err != nil {
+		t.Fatalf("UnstarRepo: %v", err)
+	}
+}
+
+func TestListStarredDecodesResponse(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/user/starred" {
+			t.Errorf("path = %s", r.URL.Path)
+		}
+		w.Write([]byte(`[{"name": "widgets", "full_name": "acme/widgets"}]`))
+	}))
+	defer server.Close()
+
+	client, err := NewClient("", t.TempDir(), 0, "")
+	if err != nil {
+		t.Fatalf("NewClient: %v", err)
+	}
+	client.BaseURL = server.URL
+
+	repos, err := client.ListStarred()
+	if err != nil {
+		t.Fatalf("ListStarred: %v", err)
+	}
+	if len(repos) != 1 || repos[0].FullName != "acme/widgets" {
+		t.Fatalf("repos = %+v", repos)
+	}
+}
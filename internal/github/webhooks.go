@@ -0,0 +1,71 @@
+package github
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+// Webhook is the subset of GitHub's repository webhook object arngit
+// needs.
+type Webhook struct {
+	ID     int64         `json:"id"`
+	Active bool          `json:"active"`
+	Events []string      `json:"events"`
+	Config WebhookConfig `json:"config"`
+}
+
+// WebhookConfig is the delivery configuration of a webhook.
+type WebhookConfig struct {
+	URL         string `json:"url"`
+	ContentType string `json:"content_type,omitempty"`
+	Secret      string `json:"secret,omitempty"`
+}
+
+// CreateWebhookRequest is the POST body for CreateWebhook.
+type CreateWebhookRequest struct {
+	Name   string        `json:"name"`
+	Active bool          `json:"active"`
+	Events []string      `json:"events"`
+	Config WebhookConfig `json:"config"`
+}
+
+func webhooksPath(owner, repo string) string {
+	return fmt.Sprintf("/repos/%s/%s/hooks", owner, repo)
+}
+
+// ListWebhooks lists the webhooks configured on owner/repo.
+func (c *Client) ListWebhooks(owner, repo string) ([]Webhook, error) {
+	var hooks []Webhook
+	err := c.getAllPages(webhooksPath(owner, repo), func(page []byte) error {
+		var batch []Webhook
+		if err := json.Unmarshal(page, &batch); err != nil {
+			return err
+		}
+		hooks = append(hooks, batch...)
+		return nil
+	})
+	return hooks, err
+}
+
+// CreateWebhook registers a new "web" webhook on owner/repo delivering
+// events to req.Config.URL.
+func (c *Client) CreateWebhook(owner, repo string, req CreateWebhookRequest) (*Webhook, error) {
+	req.Name = "web"
+	if req.Config.ContentType == "" {
+		req.Config.ContentType = "json"
+	}
+	body, err := c.post(webhooksPath(owner, repo), req)
+	if err != nil {
+		return nil, err
+	}
+	var hook Webhook
+	if err := json.Unmarshal(body, &hook); err != nil {
+		return nil, err
+	}
+	return &hook, nil
+}
+
+// DeleteWebhook removes the webhook identified by id from owner/repo.
+func (c *Client) DeleteWebhook(owner, repo string, id int64) error {
+	return c.delete(fmt.Sprintf("%s/%d", webhooksPath(owner, repo), id))
+}
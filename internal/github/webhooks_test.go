@@ -0,0 +1,105 @@
+package github
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestListWebhooksDecodesResponse(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/repos/acme/widgets/hooks" {
+			t.Errorf("path = %s", r.URL.Path)
+		}
+		w.Write([]byte(`[{"id": 1, "active": true, "events": ["push"], "config": {"url": "https://ci.example.com/hook"}}]`))
+	}))
+	defer server.Close()
+
+	client, err := NewClient("", t.TempDir(), 0, "")
+	if err != nil {
+		t.Fatalf("NewClient: %v", err)
+	}
+	client.BaseURL = server.URL
+
+	hooks, err := client.ListWebhooks("acme", "widgets")
+	if err != nil {
+		t.Fatalf("ListWebhooks: %v", err)
+	}
+	if len(hooks) != 1 || hooks[0].ID != 1 || hooks[0].Config.URL != "https://ci.example.com/hook" {
+		t.Fatalf("hooks = %+v", hooks)
+	}
+}
+
+func TestCreateWebhookSendsExpectedBody(t *testing.T) {
+	var gotBody CreateWebhookRequest
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost {
+			t.Errorf("method = %s, want POST", r.Method)
+		}
+		if r.URL.Path != "/repos/acme/widgets/hooks" {
+			t.Errorf("path = %s", r.URL.Path)
+		}
+		data, err := io.ReadAll(r.Body)
+		if err != nil {
+			t.Fatalf("reading body: %v", err)
+		}
+		if err := json.Unmarshal(data, &gotBody); err != nil {
+			t.Fatalf("unmarshaling body: %v", err)
+		}
+		w.WriteHeader(http.StatusCreated)
+		w.Write([]byte(`{"id": 42, "active": true, "events": ["push"], "config": {"url": "https://ci.example.com/hook", "content_type": "json"}}`))
+	}))
+	defer server.Close()
+
+	client, err := NewClient("", t.TempDir(), 0, "")
+	if err != nil {
+		t.Fatalf("NewClient: %v", err)
+	}
+	client.BaseURL = server.URL
+
+	hook, err := client.CreateWebhook("acme", "widgets", CreateWebhookRequest{
+		Active: true,
+		Events: []string{"push"},
+		Config: WebhookConfig{URL: "https://ci.example.com/hook", Secret: "shh"},
+	})
+	if err != nil {
+		t.Fatalf("CreateWebhook: %v", err)
+	}
+	if hook.ID != 42 {
+		t.Errorf("hook.ID = %d, want 42", hook.ID)
+	}
+	if gotBody.Name != "web" {
+		t.Errorf("Name in POST body = %q, want %q", gotBody.Name, "web")
+	}
+	if gotBody.Config.ContentType != "json" {
+		t.Errorf("Config.ContentType in POST body = %q, want %q", gotBody.Config.ContentType, "json")
+	}
+	if gotBody.Config.Secret != "shh" {
+		t.Errorf("Config.Secret in POST body = %q, want %q", gotBody.Config.Secret, "shh")
+	}
+}
+
+func TestDeleteWebhookSendsDelete(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodDelete {
+			t.Errorf("method = %s, want DELETE", r.Method)
+		}
+		if r.URL.Path != "/repos/acme/widgets/hooks/42" {
+			t.Errorf("path = %s", r.URL.Path)
+		}
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer server.Close()
+
+	client, err := NewClient("", t.TempDir(), 0, "")
+	if err != nil {
+		t.Fatalf("NewClient: %v", err)
+	}
+	client.BaseURL = server.URL
+
+	if err := client.DeleteWebhook("acme", "widgets", 42); err != nil {
+		t.Fatalf("DeleteWebhook: %v", err)
+	}
+}
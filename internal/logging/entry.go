@@ -0,0 +1,31 @@
+package logging
+
+import (
+	"strings"
+	"time"
+)
+
+// Entry is a single parsed log line.
+type Entry struct {
+	Time    time.Time
+	Level   Level
+	Message string
+}
+
+// ParseLine parses a "<RFC3339> <LEVEL> <message>" line as written by
+// Logger.Log. It returns ok=false for anything that doesn't match, so
+// malformed or manually-edited lines are skipped rather than crashing
+// `logs`.
+func ParseLine(line string) (entry Entry, ok bool) {
+	parts := strings.SplitN(line, " ", 3)
+	if len(parts) != 3 {
+		return Entry{}, false
+	}
+
+	ts, err := time.Parse(time.RFC3339, parts[0])
+	if err != nil {
+		return Entry{}, false
+	}
+
+	return Entry{Time: ts, Level: Level(parts[1]), Message: parts[2]}, true
+}
@@ -0,0 +1,22 @@
+package logging
+
+import "testing"
+
+func TestParseLine(t *testing.T) {
+	entry, ok := ParseLine("2026-08-09T10:00:00Z ERROR push failed")
+	if !ok {
+		t.Fatal("expected line to parse")
+	}
+	if entry.Level != LevelError {
+		t.Fatalf("Level = %q, want ERROR", entry.Level)
+	}
+	if entry.Message != "push failed" {
+		t.Fatalf("Message = %q, want %q", entry.Message, "push failed")
+	}
+}
+
+func TestParseLineInvalid(t *testing.T) {
+	if _, ok := ParseLine("not a log line"); ok {
+		t.Fatal("expected malformed line to fail to parse")
+	}
+}
@@ -0,0 +1,145 @@
+// Package logging writes arngit's own operational log
+// (~/.arngit/logs/arngit.log), redacting secrets before anything hits disk
+// and rotating the file once it grows too large.
+package logging
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+)
+
+// Level is the severity of a log line.
+type Level string
+
+const (
+	LevelInfo  Level = "INFO"
+	LevelWarn  Level = "WARN"
+	LevelError Level = "ERROR"
+)
+
+const (
+	// defaultMaxSizeBytes is the size at which the log file rotates.
+	defaultMaxSizeBytes = 5 * 1024 * 1024
+
+	// defaultMaxBackups is how many rotated files (arngit.log.1, .2, ...)
+	// are kept before the oldest is discarded.
+	defaultMaxBackups = 3
+)
+
+// Logger appends redacted, timestamped lines to a file, rotating it once it
+// exceeds MaxSizeBytes.
+type Logger struct {
+	path string
+
+	MaxSizeBytes int64
+	MaxBackups   int
+}
+
+// NewLogger returns a Logger writing to path, creating its parent directory
+// if needed.
+func NewLogger(path string) (*Logger, error) {
+	if err := os.MkdirAll(filepath.Dir(path), 0o700); err != nil {
+		return nil, err
+	}
+	return &Logger{
+		path:         path,
+		MaxSizeBytes: defaultMaxSizeBytes,
+		MaxBackups:   defaultMaxBackups,
+	}, nil
+}
+
+// Log appends one line: "<timestamp> <level> <message>", with msg passed
+// through Redact first. It rotates the log file first if needed.
+func (l *Logger) Log(level Level, msg string) error {
+	if err := l.rotateIfNeeded(); err != nil {
+		return err
+	}
+
+	f, err := os.OpenFile(l.path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0o600)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	line := fmt.Sprintf("%s %s %s\n", time.Now().UTC().Format(time.RFC3339), level, Redact(msg))
+	_, err = f.WriteString(line)
+	return err
+}
+
+// rotateIfNeeded shifts arngit.log -> arngit.log.1 -> arngit.log.2 ... when
+// the active log file has grown past MaxSizeBytes, dropping anything past
+// MaxBackups.
+func (l *Logger) rotateIfNeeded() error {
+	info, err := os.Stat(l.path)
+	if os.IsNotExist(err) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	if info.Size() < l.MaxSizeBytes {
+		return nil
+	}
+
+	oldest := fmt.Sprintf("%s.%d", l.path, l.MaxBackups)
+	if err := os.Remove(oldest); err != nil && !os.IsNotExist(err) {
+		return err
+	}
+
+	for i := l.MaxBackups - 1; i >= 1; i-- {
+		src := fmt.Sprintf("%s.%d", l.path, i)
+		dst := fmt.Sprintf("%s.%d", l.path, i+1)
+		if err := os.Rename(src, dst); err != nil && !os.IsNotExist(err) {
+			return err
+		}
+	}
+
+	return os.Rename(l.path, l.path+".1")
+}
+
+// Info logs an informational line.
+func (l *Logger) Info(msg string) error { return l.Log(LevelInfo, msg) }
+
+// Warn logs a warning line.
+func (l *Logger) Warn(msg string) error { return l.Log(LevelWarn, msg) }
+
+// Error logs an error line.
+func (l *Logger) Error(msg string) error { return l.Log(LevelError, msg) }
+
+// Path returns the active log file's path.
+func (l *Logger) Path() string { return l.path }
+
+// Clear removes the active log file and every rotated backup.
+func (l *Logger) Clear() error {
+	if err := os.Remove(l.path); err != nil && !os.IsNotExist(err) {
+		return err
+	}
+	for i := 1; i <= l.MaxBackups; i++ {
+		backup := fmt.Sprintf("%s.%d", l.path, i)
+		if err := os.Remove(backup); err != nil && !os.IsNotExist(err) {
+			return err
+		}
+	}
+	return nil
+}
+
+// Lines returns the active log file's contents as individual lines, oldest
+// first. A missing file yields no lines.
+func (l *Logger) Lines() ([]string, error) {
+	data, err := os.ReadFile(l.path)
+	if os.IsNotExist(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	text := strings.TrimRight(string(data), "\n")
+	if text == "" {
+		return nil, nil
+	}
+	return strings.Split(text, "\n"), nil
+}
@@ -0,0 +1,39 @@
+package ui
+
+import (
+	"os"
+)
+
+// IsTTY reports whether f is attached to a terminal, used as the last-resort
+// signal for whether to emit color.
+func IsTTY(f *os.File) bool {
+	info, err := f.Stat()
+	if err != nil {
+		return false
+	}
+	return info.Mode()&os.ModeCharDevice != 0
+}
+
+// ResolveColor decides whether color output should be enabled, in order of
+// precedence:
+//
+//  1. flag, an explicit --color/--no-color CLI flag (nil if not passed)
+//  2. the NO_COLOR env var (any value disables color, per no-color.org)
+//  3. the FORCE_COLOR env var (any value enables color)
+//  4. configColor, the user's persisted config.yaml setting (nil if unset)
+//  5. isTTY, auto-detected from the output stream
+func ResolveColor(flag *bool, configColor *bool, isTTY bool) bool {
+	if flag != nil {
+		return *flag
+	}
+	if os.Getenv("NO_COLOR") != "" {
+		return false
+	}
+	if os.Getenv("FORCE_COLOR") != "" {
+		return true
+	}
+	if configColor != nil {
+		return *configColor
+	}
+	return isTTY
+}
@@ -0,0 +1,38 @@
+package ui
+
+import "testing"
+
+func boolPtr(b bool) *bool { return &b }
+
+func TestResolveColorPrecedence(t *testing.T) {
+	trueVal, falseVal := boolPtr(true), boolPtr(false)
+
+	tests := []struct {
+		name        string
+		flag        *bool
+		noColor     string
+		forceColor  string
+		configColor *bool
+		isTTY       bool
+		want        bool
+	}{
+		{"flag wins over everything", falseVal, "1", "1", trueVal, true, false},
+		{"NO_COLOR wins over FORCE_COLOR", nil, "1", "1", trueVal, true, false},
+		{"FORCE_COLOR wins over config", nil, "", "1", falseVal, false, true},
+		{"config wins over tty", nil, "", "", falseVal, true, false},
+		{"tty is the last resort", nil, "", "", nil, true, true},
+		{"tty false with nothing else set", nil, "", "", nil, false, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Setenv("NO_COLOR", tt.noColor)
+			t.Setenv("FORCE_COLOR", tt.forceColor)
+
+			got := ResolveColor(tt.flag, tt.configColor, tt.isTTY)
+			if got != tt.want {
+				t.Errorf("ResolveColor() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,19 @@
+package ui
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"strings"
+)
+
+// Confirm prints prompt with a "[y/N]" suffix to out, reads a line from in,
+// and reports whether the user answered yes. Anything but "y"/"yes"
+// (case-insensitive) is treated as no, matching the fail-safe default used
+// for every destructive action in arngit.
+func Confirm(in io.Reader, out io.Writer, prompt string) bool {
+	fmt.Fprintf(out, "%s [y/N]: ", prompt)
+	line, _ := bufio.NewReader(in).ReadString('\n')
+	line = strings.ToLower(strings.TrimSpace(line))
+	return line == "y" || line == "yes"
+}
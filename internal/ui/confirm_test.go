@@ -0,0 +1,29 @@
+package ui
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+)
+
+func TestConfirm(t *testing.T) {
+	tests := map[string]bool{
+		"y\n":     true,
+		"yes\n":   true,
+		"Y\n":     true,
+		"n\n":     false,
+		"\n":      false,
+		"maybe\n": false,
+	}
+
+	for input, want := range tests {
+		var out bytes.Buffer
+		got := Confirm(strings.NewReader(input), &out, "proceed?")
+		if got != want {
+			t.Errorf("Confirm(%q) = %v, want %v", input, got, want)
+		}
+		if !strings.Contains(out.String(), "[y/N]") {
+			t.Errorf("expected prompt to include [y/N], got %q", out.String())
+		}
+	}
+}
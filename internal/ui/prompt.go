@@ -0,0 +1,16 @@
+package ui
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"strings"
+)
+
+// Prompt prints label with a trailing ": " to out, reads a line from in,
+// and returns it trimmed of surrounding whitespace.
+func Prompt(in io.Reader, out io.Writer, label string) string {
+	fmt.Fprintf(out, "%s: ", label)
+	line, _ := bufio.NewReader(in).ReadString('\n')
+	return strings.TrimSpace(line)
+}
@@ -0,0 +1,83 @@
+// Package ui renders CLI output: colored status lines and the interactive
+// dashboard.
+package ui
+
+import (
+	"fmt"
+	"strings"
+)
+
+// ANSI color codes used by the "default" theme and as raw building blocks
+// elsewhere.
+const (
+	Green  = "\033[32m"
+	Red    = "\033[31m"
+	Yellow = "\033[33m"
+	Cyan   = "\033[36m"
+	Reset  = "\033[0m"
+)
+
+// Renderer prints status, error, and info lines, honoring whether color is
+// enabled, which Theme is active, and whether output is restricted to
+// ASCII-only symbols (see ResolveASCIIOnly).
+type Renderer struct {
+	Color     bool
+	Theme     Theme
+	ASCIIOnly bool
+}
+
+// NewRenderer returns a Renderer with color enabled according to color and
+// colors sourced from the named theme preset.
+func NewRenderer(color bool, themeName string) *Renderer {
+	return &Renderer{Color: color, Theme: ThemeByName(themeName)}
+}
+
+func (r *Renderer) wrap(code, symbol, msg string) string {
+	if !r.Color {
+		return fmt.Sprintf("%s %s", symbol, msg)
+	}
+	return fmt.Sprintf("%s%s %s%s", code, symbol, msg, Reset)
+}
+
+// Success prints a checkmark line in the theme's success color.
+func (r *Renderer) Success(msg string) {
+	fmt.Println(r.wrap(r.Theme.Success, SymbolsFor(r.ASCIIOnly).Success, msg))
+}
+
+// Error prints a cross line in the theme's error color.
+func (r *Renderer) Error(msg string) {
+	fmt.Println(r.wrap(r.Theme.Error, SymbolsFor(r.ASCIIOnly).Error, msg))
+}
+
+// Warn prints a warning line in the theme's hint color.
+func (r *Renderer) Warn(msg string) {
+	fmt.Println(r.wrap(r.Theme.Hint, "!", msg))
+}
+
+// Info prints an info line in the theme's accent color.
+func (r *Renderer) Info(msg string) {
+	fmt.Println(r.wrap(r.Theme.Accent, "i", msg))
+}
+
+// Title prints a header line in the theme's title color.
+func (r *Renderer) Title(msg string) {
+	fmt.Println(r.wrap(r.Theme.Title, "#", msg))
+}
+
+// Plain prints msg with no color or symbol, for raw command output.
+func (r *Renderer) Plain(msg string) {
+	fmt.Println(msg)
+}
+
+// Bar draws a filled/empty block bar width glyphs wide, filled of them
+// solid, honoring ASCIIOnly. filled is clamped to [0, width].
+func (r *Renderer) Bar(filled, width int) string {
+	if filled > width {
+		filled = width
+	}
+	if filled < 0 {
+		filled = 0
+	}
+	symbols := SymbolsFor(r.ASCIIOnly)
+	return strings.Repeat(symbols.BarFull, filled) + strings.Repeat(symbols.BarEmpty, width-filled)
+}
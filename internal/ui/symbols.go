@@ -0,0 +1,56 @@
+package ui
+
+import (
+	"os"
+	"strings"
+)
+
+// Symbols is the glyph set a Renderer draws status lines and bars with.
+// Unicode terminals get checkmarks and solid blocks; ASCII-only terminals
+// (see ResolveASCIIOnly) get a plain-text equivalent that won't render as
+// mojibake.
+type Symbols struct {
+	Success  string
+	Error    string
+	BarFull  string
+	BarEmpty string
+}
+
+var unicodeSymbols = Symbols{Success: "✓", Error: "✗", BarFull: "█", BarEmpty: "░"}
+
+var asciiSymbols = Symbols{Success: "[OK]", Error: "[X]", BarFull: "#", BarEmpty: "-"}
+
+// SymbolsFor returns the glyph set a Renderer should use, given whether
+// it's running in ASCII-only mode.
+func SymbolsFor(asciiOnly bool) Symbols {
+	if asciiOnly {
+		return asciiSymbols
+	}
+	return unicodeSymbols
+}
+
+// ResolveASCIIOnly decides whether output should be restricted to ASCII
+// symbols, in order of precedence:
+//
+//  1. configASCII, the user's persisted config.yaml setting (nil if unset)
+//  2. auto-detected from LC_ALL, falling back to LANG: a locale that
+//     doesn't mention UTF-8 (including the classic "C"/"POSIX" locale, or
+//     no locale set at all) can't reliably render Unicode glyphs.
+func ResolveASCIIOnly(configASCII *bool, lcAll, lang string) bool {
+	if configASCII != nil {
+		return *configASCII
+	}
+	locale := lcAll
+	if locale == "" {
+		locale = lang
+	}
+	if locale == "" || locale == "C" || locale == "POSIX" {
+		return true
+	}
+	return !strings.Contains(strings.ToUpper(locale), "UTF-8") && !strings.Contains(strings.ToUpper(locale), "UTF8")
+}
+
+// DetectASCIIOnly is ResolveASCIIOnly sourced from the process environment.
+func DetectASCIIOnly(configASCII *bool) bool {
+	return ResolveASCIIOnly(configASCII, os.Getenv("LC_ALL"), os.Getenv("LANG"))
+}
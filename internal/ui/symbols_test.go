@@ -0,0 +1,38 @@
+package ui
+
+import "testing"
+
+func TestResolveASCIIOnlyPrecedence(t *testing.T) {
+	tests := []struct {
+		name   string
+		config *bool
+		lcAll  string
+		lang   string
+		want   bool
+	}{
+		{"config true wins over utf-8 locale", boolPtr(true), "en_US.UTF-8", "en_US.UTF-8", true},
+		{"config false wins over empty locale", boolPtr(false), "", "", false},
+		{"LC_ALL wins over LANG", nil, "C", "en_US.UTF-8", true},
+		{"utf-8 LANG is not ascii-only", nil, "", "en_US.UTF-8", false},
+		{"plain C locale is ascii-only", nil, "", "C", true},
+		{"no locale set is ascii-only", nil, "", "", true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := ResolveASCIIOnly(tt.config, tt.lcAll, tt.lang)
+			if got != tt.want {
+				t.Errorf("ResolveASCIIOnly() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestSymbolsFor(t *testing.T) {
+	if got := SymbolsFor(false); got != unicodeSymbols {
+		t.Errorf("SymbolsFor(false) = %+v, want %+v", got, unicodeSymbols)
+	}
+	if got := SymbolsFor(true); got != asciiSymbols {
+		t.Errorf("SymbolsFor(true) = %+v, want %+v", got, asciiSymbols)
+	}
+}
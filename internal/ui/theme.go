@@ -0,0 +1,66 @@
+package ui
+
+import "sort"
+
+// Theme maps semantic output roles to ANSI color codes. It's how
+// Config.Theme actually changes what gets printed.
+type Theme struct {
+	Success string
+	Error   string
+	Title   string
+	Hint    string
+	Accent  string
+}
+
+// themes holds the built-in presets, keyed by the name stored in
+// Config.Theme.
+var themes = map[string]Theme{
+	"default": {
+		Success: Green,
+		Error:   Red,
+		Title:   Cyan,
+		Hint:    Yellow,
+		Accent:  Cyan,
+	},
+	"dark": {
+		Success: "\033[38;5;78m",
+		Error:   "\033[38;5;203m",
+		Title:   "\033[38;5;111m",
+		Hint:    "\033[38;5;180m",
+		Accent:  "\033[38;5;141m",
+	},
+	"light": {
+		Success: "\033[38;5;28m",
+		Error:   "\033[38;5;160m",
+		Title:   "\033[38;5;24m",
+		Hint:    "\033[38;5;94m",
+		Accent:  "\033[38;5;27m",
+	},
+	"mono": {
+		Success: "",
+		Error:   "",
+		Title:   "",
+		Hint:    "",
+		Accent:  "",
+	},
+}
+
+// ThemeByName returns the named preset, falling back to "default" for an
+// unknown name.
+func ThemeByName(name string) Theme {
+	if t, ok := themes[name]; ok {
+		return t
+	}
+	return themes["default"]
+}
+
+// ThemeNames returns the names of the built-in theme presets, for
+// validating `config set theme` against.
+func ThemeNames() []string {
+	names := make([]string, 0, len(themes))
+	for name := range themes {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
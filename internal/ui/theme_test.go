@@ -0,0 +1,29 @@
+package ui
+
+import "testing"
+
+func TestThemeSwitchChangesEmittedCodes(t *testing.T) {
+	dark := NewRenderer(true, "dark")
+	light := NewRenderer(true, "light")
+
+	if dark.Theme.Success == light.Theme.Success {
+		t.Fatal("dark and light themes should emit different success codes")
+	}
+	if dark.wrap(dark.Theme.Success, "✓", "ok") == light.wrap(light.Theme.Success, "✓", "ok") {
+		t.Fatal("expected different rendered output between themes")
+	}
+}
+
+func TestUnknownThemeFallsBackToDefault(t *testing.T) {
+	r := NewRenderer(true, "does-not-exist")
+	if r.Theme != ThemeByName("default") {
+		t.Fatalf("unknown theme should fall back to default, got %+v", r.Theme)
+	}
+}
+
+func TestMonoThemeEmitsNoColorCodes(t *testing.T) {
+	r := NewRenderer(true, "mono")
+	if r.Theme.Success != "" {
+		t.Fatalf("mono theme should have an empty success code, got %q", r.Theme.Success)
+	}
+}
@@ -0,0 +1,306 @@
+// Package update implements arngit's self-update: checking GitHub Releases
+// for a newer version, downloading the right asset, and verifying it before
+// it replaces the running binary.
+package update
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"runtime"
+	"strings"
+	"time"
+)
+
+// defaultRepo is where release assets are published.
+const defaultRepo = "buchorim/arngit"
+
+// defaultBaseURL is the GitHub API root; tests override Manager.BaseURL to
+// point at an httptest.Server instead.
+const defaultBaseURL = "https://api.github.com"
+
+// defaultTimeout bounds a request when no explicit timeout is configured.
+const defaultTimeout = 30 * time.Second
+
+// Asset is one file attached to a GitHub release.
+type Asset struct {
+	Name               string `json:"name"`
+	BrowserDownloadURL string `json:"browser_download_url"`
+}
+
+// Release is the subset of the GitHub releases API response arngit needs.
+type Release struct {
+	TagName    string  `json:"tag_name"`
+	Prerelease bool    `json:"prerelease"`
+	Assets     []Asset `json:"assets"`
+}
+
+// Manager checks for, downloads, and verifies arngit updates.
+type Manager struct {
+	CurrentVersion string
+	Repo           string
+
+	// BaseURL is the GitHub API root, overridable so tests can point it at
+	// an httptest.Server.
+	BaseURL string
+
+	// Channel is "stable" (the default), "beta", or "nightly". Stable only
+	// ever sees GitHub's non-prerelease "latest" release; beta and nightly
+	// both fetch the full release list and pick the newest prerelease.
+	Channel string
+
+	// Token, if set, is sent as a bearer token to avoid GitHub's low
+	// unauthenticated rate limit on the releases endpoint.
+	Token string
+
+	// LastCheckPath, if set, is where ShouldCheck/RecordCheck persist the
+	// time of the last update check, so a fresh process doesn't hit the
+	// network on every startup. Empty disables the rate limit: ShouldCheck
+	// always returns true and RecordCheck is a no-op.
+	LastCheckPath string
+
+	HTTPClient *http.Client
+}
+
+// NewManager returns a Manager for the current running version, defaulting
+// to the stable channel. timeout <= 0 falls back to defaultTimeout.
+// proxyURL, if set, overrides the HTTPS_PROXY/HTTP_PROXY/NO_PROXY
+// environment for this manager's requests; empty defers to the environment.
+func NewManager(currentVersion, token string, timeout time.Duration, proxyURL string) (*Manager, error) {
+	if timeout <= 0 {
+		timeout = defaultTimeout
+	}
+
+	proxyFunc, err := proxyFuncFor(proxyURL)
+	if err != nil {
+		return nil, err
+	}
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	transport.Proxy = proxyFunc
+
+	return &Manager{
+		CurrentVersion: currentVersion,
+		Repo:           defaultRepo,
+		BaseURL:        defaultBaseURL,
+		Channel:        "stable",
+		Token:          token,
+		HTTPClient:     &http.Client{Timeout: timeout, Transport: transport},
+	}, nil
+}
+
+// proxyFuncFor returns a request-proxy resolver: a fixed proxyURL if given,
+// otherwise the standard HTTPS_PROXY/HTTP_PROXY/NO_PROXY environment
+// resolution.
+func proxyFuncFor(proxyURL string) (func(*http.Request) (*url.URL, error), error) {
+	if proxyURL == "" {
+		return http.ProxyFromEnvironment, nil
+	}
+	fixed, err := url.Parse(proxyURL)
+	if err != nil {
+		return nil, fmt.Errorf("invalid proxy URL %q: %w", proxyURL, err)
+	}
+	return http.ProxyURL(fixed), nil
+}
+
+// get issues a GET to url, setting a User-Agent and, if m.Token is set, an
+// Authorization header, so update checks never fall back to GitHub's low
+// unauthenticated rate limit.
+func (m *Manager) get(url string) (*http.Response, error) {
+	req, err := http.NewRequest(http.MethodGet, url, nil)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("User-Agent", "arngit/"+m.CurrentVersion)
+	if m.Token != "" {
+		req.Header.Set("Authorization", "Bearer "+m.Token)
+	}
+	return m.HTTPClient.Do(req)
+}
+
+// LatestRelease fetches the newest release for m.Channel.
+func (m *Manager) LatestRelease() (*Release, error) {
+	if m.Channel == "beta" || m.Channel == "nightly" {
+		return m.latestPrerelease()
+	}
+	return m.latestStable()
+}
+
+func (m *Manager) latestStable() (*Release, error) {
+	url := fmt.Sprintf("%s/repos/%s/releases/latest", m.BaseURL, m.Repo)
+	resp, err := m.get(url)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("fetching latest release: unexpected status %d", resp.StatusCode)
+	}
+
+	var release Release
+	if err := json.NewDecoder(resp.Body).Decode(&release); err != nil {
+		return nil, err
+	}
+	return &release, nil
+}
+
+// latestPrerelease returns the newest prerelease from the full release
+// list, which GitHub returns newest-first. Used by both the beta and
+// nightly channels.
+func (m *Manager) latestPrerelease() (*Release, error) {
+	url := fmt.Sprintf("%s/repos/%s/releases", m.BaseURL, m.Repo)
+	resp, err := m.get(url)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("fetching releases: unexpected status %d", resp.StatusCode)
+	}
+
+	var releases []Release
+	if err := json.NewDecoder(resp.Body).Decode(&releases); err != nil {
+		return nil, err
+	}
+	for _, r := range releases {
+		if r.Prerelease {
+			return &r, nil
+		}
+	}
+	return nil, fmt.Errorf("no prerelease found for %s", m.Repo)
+}
+
+// AssetName returns the expected binary asset name for the running platform,
+// e.g. "arngit_linux_amd64" or "arngit_windows_amd64.exe".
+func AssetName() string {
+	name := fmt.Sprintf("arngit_%s_%s", runtime.GOOS, runtime.GOARCH)
+	if runtime.GOOS == "windows" {
+		name += ".exe"
+	}
+	return name
+}
+
+// FindAsset returns the asset in release matching name, or an error if none
+// matches.
+func (r *Release) FindAsset(name string) (Asset, error) {
+	for _, a := range r.Assets {
+		if a.Name == name {
+			return a, nil
+		}
+	}
+	return Asset{}, fmt.Errorf("no release asset named %q", name)
+}
+
+// Download fetches url into destDir, using the URL's basename as the file
+// name, and returns the full path written.
+func (m *Manager) Download(url, destDir string) (string, error) {
+	resp, err := m.get(url)
+	if err != nil {
+		return "", err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return "", fmt.Errorf("downloading %s: unexpected status %d", url, resp.StatusCode)
+	}
+
+	if err := os.MkdirAll(destDir, 0o700); err != nil {
+		return "", err
+	}
+
+	path := filepath.Join(destDir, filepath.Base(url))
+	f, err := os.Create(path)
+	if err != nil {
+		return "", err
+	}
+	defer f.Close()
+
+	if _, err := io.Copy(f, resp.Body); err != nil {
+		return "", err
+	}
+	return path, nil
+}
+
+// ChecksumSHA256 returns the lowercase hex SHA-256 digest of the file at
+// path.
+func ChecksumSHA256(path string) (string, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return "", err
+	}
+	defer f.Close()
+
+	h := sha256.New()
+	if _, err := io.Copy(h, f); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(h.Sum(nil)), nil
+}
+
+// ParseChecksums parses a standard `sha256sum` output file ("<hex>
+// <filename>" per line) into a name-to-digest map.
+func ParseChecksums(data []byte) map[string]string {
+	sums := make(map[string]string)
+	for _, line := range strings.Split(string(data), "\n") {
+		fields := strings.Fields(line)
+		if len(fields) != 2 {
+			continue
+		}
+		sums[fields[1]] = fields[0]
+	}
+	return sums
+}
+
+// VerifyChecksum fails unless the file at path hashes to expectedHex.
+func VerifyChecksum(path, expectedHex string) error {
+	actual, err := ChecksumSHA256(path)
+	if err != nil {
+		return err
+	}
+	if !strings.EqualFold(actual, expectedHex) {
+		return fmt.Errorf("checksum mismatch for %s: got %s, want %s", filepath.Base(path), actual, expectedHex)
+	}
+	return nil
+}
+
+// Apply installs newBinaryPath in place of currentPath. On Unix it marks the
+// file executable first (downloaded files aren't). On Windows the running
+// binary can't be overwritten directly, so the current one is moved aside
+// as "<name>.old" before the new one takes its place.
+func Apply(newBinaryPath, currentPath string) error {
+	if runtime.GOOS != "windows" {
+		if err := os.Chmod(newBinaryPath, 0o755); err != nil {
+			return err
+		}
+	}
+
+	if runtime.GOOS == "windows" {
+		old := currentPath + ".old"
+		_ = os.Remove(old)
+		if err := os.Rename(currentPath, old); err != nil {
+			return err
+		}
+	}
+
+	return os.Rename(newBinaryPath, currentPath)
+}
+
+// ApplyToRunningBinary is Apply for the currently running executable.
+func ApplyToRunningBinary(newBinaryPath string) error {
+	current, err := os.Executable()
+	if err != nil {
+		return err
+	}
+	current, err = filepath.EvalSymlinks(current)
+	if err != nil {
+		return err
+	}
+	return Apply(newBinaryPath, current)
+}
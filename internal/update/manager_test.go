@@ -0,0 +1,182 @@
+package update
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"runtime"
+	"testing"
+)
+
+func TestParseChecksums(t *testing.T) {
+	data := []byte("abc123  arngit_linux_amd64\ndef456  arngit_darwin_amd64\n")
+	sums := ParseChecksums(data)
+	if sums["arngit_linux_amd64"] != "abc123" {
+		t.Fatalf("sums[arngit_linux_amd64] = %q, want abc123", sums["arngit_linux_amd64"])
+	}
+	if sums["arngit_darwin_amd64"] != "def456" {
+		t.Fatalf("sums[arngit_darwin_amd64] = %q, want def456", sums["arngit_darwin_amd64"])
+	}
+}
+
+func TestVerifyChecksumMismatch(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "asset")
+	if err := os.WriteFile(path, []byte("hello"), 0o644); err != nil {
+		t.Fatalf("WriteFile: %v", err)
+	}
+
+	if err := VerifyChecksum(path, "not-the-real-hash"); err == nil {
+		t.Fatal("expected checksum mismatch error")
+	}
+}
+
+func TestNewManagerDefaultsToStableChannel(t *testing.T) {
+	mgr, err := NewManager("v1.0.0", "", 0, "")
+	if err != nil {
+		t.Fatalf("NewManager: %v", err)
+	}
+	if mgr.Channel != "stable" {
+		t.Fatalf("Channel = %q, want stable", mgr.Channel)
+	}
+}
+
+func TestLatestReleaseNightlySelectsPrerelease(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/repos/acme/widgets/releases" {
+			t.Fatalf("unexpected path %q", r.URL.Path)
+		}
+		w.Write([]byte(`[
+			{"tag_name": "v2.0.0-rc1", "prerelease": true},
+			{"tag_name": "v1.0.0", "prerelease": false}
+		]`))
+	}))
+	defer server.Close()
+
+	mgr, err := NewManager("v1.0.0", "", 0, "")
+	if err != nil {
+		t.Fatalf("NewManager: %v", err)
+	}
+	mgr.Repo = "acme/widgets"
+	mgr.BaseURL = server.URL
+	mgr.Channel = "nightly"
+
+	release, err := mgr.LatestRelease()
+	if err != nil {
+		t.Fatalf("LatestRelease: %v", err)
+	}
+	if release.TagName != "v2.0.0-rc1" {
+		t.Fatalf("TagName = %q, want v2.0.0-rc1", release.TagName)
+	}
+}
+
+func TestLatestReleaseStableIgnoresPrerelease(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/repos/acme/widgets/releases/latest" {
+			t.Fatalf("unexpected path %q", r.URL.Path)
+		}
+		w.Write([]byte(`{"tag_name": "v1.0.0", "prerelease": false}`))
+	}))
+	defer server.Close()
+
+	mgr, err := NewManager("v1.0.0", "", 0, "")
+	if err != nil {
+		t.Fatalf("NewManager: %v", err)
+	}
+	mgr.Repo = "acme/widgets"
+	mgr.BaseURL = server.URL
+	mgr.Channel = "stable"
+
+	release, err := mgr.LatestRelease()
+	if err != nil {
+		t.Fatalf("LatestRelease: %v", err)
+	}
+	if release.TagName != "v1.0.0" {
+		t.Fatalf("TagName = %q, want v1.0.0", release.TagName)
+	}
+}
+
+func TestNewManagerInvalidProxy(t *testing.T) {
+	if _, err := NewManager("v1.0.0", "", 0, "://not-a-url"); err == nil {
+		t.Error("expected error for invalid proxy URL")
+	}
+}
+
+func TestManagerUsesConfiguredClientAndHeaders(t *testing.T) {
+	var gotUserAgent, gotAuth string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		gotUserAgent = r.Header.Get("User-Agent")
+		gotAuth = r.Header.Get("Authorization")
+		w.Write([]byte(`{"tag_name": "v2.0.0"}`))
+	}))
+	defer server.Close()
+
+	mgr, err := NewManager("v1.0.0", "test-token", 0, "")
+	if err != nil {
+		t.Fatalf("NewManager: %v", err)
+	}
+	mgr.Repo = "ignored/for-this-test"
+
+	resp, err := mgr.get(server.URL)
+	if err != nil {
+		t.Fatalf("get: %v", err)
+	}
+	resp.Body.Close()
+
+	if gotUserAgent != "arngit/v1.0.0" {
+		t.Errorf("User-Agent = %q, want arngit/v1.0.0", gotUserAgent)
+	}
+	if gotAuth != "Bearer test-token" {
+		t.Errorf("Authorization = %q, want Bearer test-token", gotAuth)
+	}
+}
+
+func TestApplyMarksBinaryExecutableAndReplacesCurrent(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("exec bit is a no-op on windows")
+	}
+
+	dir := t.TempDir()
+	current := filepath.Join(dir, "arngit")
+	if err := os.WriteFile(current, []byte("old"), 0o755); err != nil {
+		t.Fatalf("WriteFile current: %v", err)
+	}
+	newBinary := filepath.Join(dir, "arngit_new")
+	if err := os.WriteFile(newBinary, []byte("new"), 0o644); err != nil {
+		t.Fatalf("WriteFile new: %v", err)
+	}
+
+	if err := Apply(newBinary, current); err != nil {
+		t.Fatalf("Apply: %v", err)
+	}
+
+	info, err := os.Stat(current)
+	if err != nil {
+		t.Fatalf("Stat: %v", err)
+	}
+	if info.Mode().Perm()&0o111 == 0 {
+		t.Fatalf("expected executable bit set, got mode %o", info.Mode().Perm())
+	}
+	data, err := os.ReadFile(current)
+	if err != nil {
+		t.Fatalf("ReadFile: %v", err)
+	}
+	if string(data) != "new" {
+		t.Fatalf("current binary content = %q, want %q", data, "new")
+	}
+}
+
+func TestVerifyChecksumMatch(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "asset")
+	if err := os.WriteFile(path, []byte("hello"), 0o644); err != nil {
+		t.Fatalf("WriteFile: %v", err)
+	}
+
+	sum, err := ChecksumSHA256(path)
+	if err != nil {
+		t.Fatalf("ChecksumSHA256: %v", err)
+	}
+	if err := VerifyChecksum(path, sum); err != nil {
+		t.Fatalf("VerifyChecksum: %v", err)
+	}
+}
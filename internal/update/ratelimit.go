@@ -0,0 +1,42 @@
+package update
+
+import (
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// ShouldCheck reports whether it's been at least interval since the last
+// check recorded via RecordCheck (or none has ever been recorded).
+// interval <= 0 or an unset LastCheckPath both mean always check.
+func (m *Manager) ShouldCheck(interval time.Duration) bool {
+	if interval <= 0 || m.LastCheckPath == "" {
+		return true
+	}
+
+	data, err := os.ReadFile(m.LastCheckPath)
+	if err != nil {
+		return true
+	}
+
+	unixSeconds, err := strconv.ParseInt(strings.TrimSpace(string(data)), 10, 64)
+	if err != nil {
+		return true
+	}
+
+	return time.Since(time.Unix(unixSeconds, 0)) >= interval
+}
+
+// RecordCheck persists the current time to LastCheckPath, for a future
+// ShouldCheck to rate-limit against. A no-op if LastCheckPath isn't set.
+func (m *Manager) RecordCheck() error {
+	if m.LastCheckPath == "" {
+		return nil
+	}
+	if err := os.MkdirAll(filepath.Dir(m.LastCheckPath), 0o700); err != nil {
+		return err
+	}
+	return os.WriteFile(m.LastCheckPath, []byte(strconv.FormatInt(time.Now().Unix(), 10)), 0o644)
+}
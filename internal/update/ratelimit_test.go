@@ -0,0 +1,71 @@
+package update
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+func TestShouldCheckWithoutPersistedCheckIsTrue(t *testing.T) {
+	mgr, err := NewManager("v1.0.0", "", 0, "")
+	if err != nil {
+		t.Fatalf("NewManager: %v", err)
+	}
+	mgr.LastCheckPath = filepath.Join(t.TempDir(), "last_update_check")
+
+	if !mgr.ShouldCheck(time.Hour) {
+		t.Fatal("expected ShouldCheck to be true when no check has been recorded")
+	}
+}
+
+func TestRecordCheckSuppressesRecentCheck(t *testing.T) {
+	mgr, err := NewManager("v1.0.0", "", 0, "")
+	if err != nil {
+		t.Fatalf("NewManager: %v", err)
+	}
+	mgr.LastCheckPath = filepath.Join(t.TempDir(), "last_update_check")
+
+	if err := mgr.RecordCheck(); err != nil {
+		t.Fatalf("RecordCheck: %v", err)
+	}
+
+	if mgr.ShouldCheck(time.Hour) {
+		t.Fatal("expected ShouldCheck to be false right after RecordCheck")
+	}
+}
+
+// TestRecentPersistedCheckSuppressesNetworkCall exercises the pattern
+// callers (Engine.runUpdateCheck) follow: only call LatestRelease if
+// ShouldCheck says to. A recent RecordCheck should mean that guard never
+// lets the request through.
+func TestRecentPersistedCheckSuppressesNetworkCall(t *testing.T) {
+	called := false
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		called = true
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte(`{"tag_name":"v2.0.0"}`))
+	}))
+	defer server.Close()
+
+	mgr, err := NewManager("v1.0.0", "", 0, "")
+	if err != nil {
+		t.Fatalf("NewManager: %v", err)
+	}
+	mgr.LastCheckPath = filepath.Join(t.TempDir(), "last_update_check")
+
+	if err := mgr.RecordCheck(); err != nil {
+		t.Fatalf("RecordCheck: %v", err)
+	}
+
+	if mgr.ShouldCheck(time.Hour) {
+		if _, err := mgr.LatestRelease(); err != nil {
+			t.Fatalf("LatestRelease: %v", err)
+		}
+	}
+
+	if called {
+		t.Fatal("expected the network call to be skipped by a recent persisted check")
+	}
+}